@@ -0,0 +1,151 @@
+package opensea
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidAddress is returned for a string that is not a 0x-prefixed
+// 20-byte hex address, or whose mixed case fails the EIP-55 checksum.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// Address is an account or contract address. Addresses from ParseAddress and
+// from decoded responses are held in lowercase, the form OpenSea uses; use
+// Checksum for the EIP-55 form. Non-EVM addresses, such as Solana's, are kept
+// as given.
+type Address string
+
+// NullAddress is the empty Address.
+const NullAddress Address = ""
+
+// ParseAddress parses a 0x-prefixed hex address. An all-lowercase or
+// all-uppercase address is accepted as is; a mixed-case one must carry a
+// valid EIP-55 checksum. The result is lowercase.
+func ParseAddress(addr string) (Address, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return "", fmt.Errorf("%w: empty address", ErrInvalidAddress)
+	}
+	if len(addr) != 42 || (addr[:2] != "0x" && addr[:2] != "0X") {
+		return "", fmt.Errorf("%w: %q is not 0x followed by 40 hex digits", ErrInvalidAddress, addr)
+	}
+	digits := addr[2:]
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "", fmt.Errorf("%w: %q is not 0x followed by 40 hex digits", ErrInvalidAddress, addr)
+	}
+
+	lower := strings.ToLower(digits)
+	if digits != lower && digits != strings.ToUpper(digits) && checksum(lower) != digits {
+		return "", fmt.Errorf("%w: %q has a bad EIP-55 checksum", ErrInvalidAddress, addr)
+	}
+	return Address("0x" + lower), nil
+}
+
+// MustParseAddress is ParseAddress for constants known to be valid. It
+// panics on error.
+func MustParseAddress(addr string) Address {
+	a, err := ParseAddress(addr)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func (a Address) String() string {
+	return string(a)
+}
+
+// IsHex reports whether a is a well-formed 0x-prefixed 20-byte hex address,
+// regardless of case.
+func (a Address) IsHex() bool {
+	s := string(a)
+	if len(s) != 42 || (s[:2] != "0x" && s[:2] != "0X") {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
+
+// Checksum returns the EIP-55 mixed-case form of a hex address. Other
+// addresses are returned unchanged.
+func (a Address) Checksum() string {
+	if !a.IsHex() {
+		return string(a)
+	}
+	return "0x" + checksum(strings.ToLower(string(a[2:])))
+}
+
+// Equal reports whether a and b are the same address. Hex addresses compare
+// case-insensitively; others must match exactly.
+func (a Address) Equal(b Address) bool {
+	return a.normalize() == b.normalize()
+}
+
+// normalize returns the canonical form of a: lowercase for hex addresses,
+// unchanged otherwise.
+func (a Address) normalize() Address {
+	if !a.IsHex() {
+		return a
+	}
+	return Address(strings.ToLower(string(a)))
+}
+
+// checksum applies EIP-55 casing to 40 lowercase hex digits.
+func checksum(lower string) string {
+	hash := keccak256([]byte(lower))
+	out := []byte(lower)
+	for i, c := range out {
+		if c < 'a' {
+			continue
+		}
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return string(out)
+}
+
+// MarshalJSON encodes the address as a string in its canonical form.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(a.normalize()))
+}
+
+// UnmarshalJSON decodes an address string, normalizing hex addresses. It is
+// lenient with other strings, which the API uses for non-EVM chains, and
+// decodes null to NullAddress.
+func (a *Address) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal address: %w", err)
+	}
+	if s == nil {
+		*a = NullAddress
+		return nil
+	}
+	*a = Address(*s).normalize()
+	return nil
+}
+
+// MarshalBSONValue encodes the address as a BSON string in its canonical
+// form.
+func (a Address) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONString(string(a.normalize()))
+}
+
+// UnmarshalBSONValue decodes a BSON string address, normalizing hex
+// addresses.
+func (a *Address) UnmarshalBSONValue(typ byte, data []byte) error {
+	s, err := unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal address: %w", err)
+	}
+	*a = Address(s).normalize()
+	return nil
+}
@@ -0,0 +1,251 @@
+package opensea
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const (
+	defaultBatchConcurrency = 4
+	// maxTokenIDsPerRequest is the most token_ids the assets endpoint takes
+	// in a single request.
+	maxTokenIDsPerRequest = 30
+)
+
+// NFTKey identifies a single NFT.
+type NFTKey struct {
+	Chain    Chain
+	Contract Address
+	TokenID  TokenID
+}
+
+func (k NFTKey) String() string {
+	return fmt.Sprintf("%s/%s/%s", k.Chain, k.Contract, k.TokenID)
+}
+
+// BatchOptions tunes a batch fetch.
+type BatchOptions struct {
+	// Concurrency is the number of requests in flight at once. Defaults to 4.
+	Concurrency int
+	// ChunkSize is the number of tokens asked for in a single request.
+	// Defaults to, and is capped at, the API maximum of 30.
+	ChunkSize int
+}
+
+func (o BatchOptions) withDefaults() BatchOptions {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultBatchConcurrency
+	}
+	if o.ChunkSize <= 0 || o.ChunkSize > maxTokenIDsPerRequest {
+		o.ChunkSize = maxTokenIDsPerRequest
+	}
+	return o
+}
+
+// NFTResult is the outcome of fetching one NFT of a batch. Exactly one of
+// Asset, NFT and Err is set: Asset for keys on the client's chain, which
+// the v1 assets endpoint serves, and NFT for keys on any other chain.
+type NFTResult struct {
+	Asset *Asset
+	NFT   *NFT
+	Err   error
+}
+
+// ContractResult is the outcome of fetching one contract of a batch.
+// Exactly one of Contract and Err is set.
+type ContractResult struct {
+	Contract *AssetContract
+	Err      error
+}
+
+// GetNFTsBatch fetches many NFTs at once. Duplicate keys are fetched once,
+// including keys that differ only in the case of a hex contract address or
+// in leaving the client's chain implicit, and share a result. Keys of the
+// same contract on the client's chain are grouped into v1
+// requests of up to opts.ChunkSize tokens, keys on other chains are fetched
+// one by one from the v2 API, and the requests run with bounded
+// concurrency. Every distinct key gets an entry in the result; tokens the
+// API did not return get ErrNotFound.
+func (c *Client) GetNFTsBatch(ctx context.Context, keys []NFTKey, opts BatchOptions) map[NFTKey]NFTResult {
+	opts = opts.withDefaults()
+	results := make(map[NFTKey]NFTResult, len(keys))
+
+	type group struct {
+		chain    Chain
+		contract Address
+	}
+	groups := map[group][]NFTKey{}
+	var order []group
+	// aliases maps each canonical key to the keys that name it.
+	aliases := map[NFTKey][]NFTKey{}
+	for _, k := range keys {
+		if _, ok := results[k]; ok {
+			continue
+		}
+		results[k] = NFTResult{}
+
+		chain, err := c.resolveChain(k.Chain)
+		if err != nil {
+			results[k] = NFTResult{Err: err}
+			continue
+		}
+		if k.Contract == NullAddress {
+			results[k] = NFTResult{Err: ErrEmptyContractAddress}
+			continue
+		}
+		if !k.TokenID.IsSet() {
+			results[k] = NFTResult{Err: fmt.Errorf("token ID cannot be empty")}
+			continue
+		}
+
+		ck := NFTKey{Chain: chain, Contract: k.Contract.normalize(), TokenID: k.TokenID}
+		if _, ok := aliases[ck]; !ok {
+			g := group{chain: chain, contract: ck.Contract}
+			if _, ok := groups[g]; !ok {
+				order = append(order, g)
+			}
+			groups[g] = append(groups[g], ck)
+		}
+		aliases[ck] = append(aliases[ck], k)
+	}
+
+	// The v1 assets endpoint has no chain parameter and only serves the
+	// client's chain, so other chains take one v2 request per token.
+	type chunk struct {
+		chain Chain
+		keys  []NFTKey
+	}
+	var chunks []chunk
+	for _, g := range order {
+		size := opts.ChunkSize
+		if g.chain != c.chain {
+			size = 1
+		}
+		for ks := groups[g]; len(ks) > 0; {
+			n := min(size, len(ks))
+			chunks = append(chunks, chunk{chain: g.chain, keys: ks[:n]})
+			ks = ks[n:]
+		}
+	}
+
+	var mu sync.Mutex
+	runBounded(len(chunks), opts.Concurrency, func(i int) {
+		chunk := chunks[i].keys
+		var found map[NFTKey]NFTResult
+		if chain := chunks[i].chain; chain == c.chain {
+			found = c.fetchNFTChunk(ctx, chunk)
+		} else {
+			found = c.fetchNFTV2(ctx, chain, chunk[0])
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, ck := range chunk {
+			for _, k := range aliases[ck] {
+				results[k] = found[ck]
+			}
+		}
+	})
+
+	return results
+}
+
+// fetchNFTChunk fetches the tokens of a single contract on the client's
+// chain in one v1 request.
+func (c *Client) fetchNFTChunk(ctx context.Context, chunk []NFTKey) map[NFTKey]NFTResult {
+	results := make(map[NFTKey]NFTResult, len(chunk))
+
+	ids := make([]TokenID, len(chunk))
+	for i, k := range chunk {
+		ids[i] = k.TokenID
+	}
+
+	resp, err := c.GetNFTsByTokenIDs(ctx, chunk[0].Contract.String(), ids)
+	for _, k := range chunk {
+		if err != nil {
+			results[k] = NFTResult{Err: err}
+			continue
+		}
+		results[k] = NFTResult{Err: fmt.Errorf("%s: %w", k, ErrNotFound)}
+	}
+	if err != nil {
+		return results
+	}
+
+	for i := range resp.Assets {
+		a := &resp.Assets[i]
+		for _, k := range chunk {
+			if a.TokenID != k.TokenID {
+				continue
+			}
+			if a.AssetContract != nil && !a.AssetContract.Address.Equal(k.Contract) {
+				continue
+			}
+			results[k] = NFTResult{Asset: a}
+		}
+	}
+	return results
+}
+
+// fetchNFTV2 fetches a single token on chain from the v2 API.
+func (c *Client) fetchNFTV2(ctx context.Context, chain Chain, k NFTKey) map[NFTKey]NFTResult {
+	nft, err := c.GetNFTV2(ctx, chain, k.Contract.String(), k.TokenID)
+	if err != nil {
+		return map[NFTKey]NFTResult{k: {Err: err}}
+	}
+	return map[NFTKey]NFTResult{k: {NFT: nft}}
+}
+
+// GetContractsBatch fetches many contracts with bounded concurrency.
+// Duplicate addresses, including hex addresses that differ only in case,
+// are fetched once and share a result.
+func (c *Client) GetContractsBatch(ctx context.Context, addresses []Address, opts BatchOptions) map[Address]ContractResult {
+	opts = opts.withDefaults()
+	results := make(map[Address]ContractResult, len(addresses))
+
+	var unique []Address
+	// aliases maps each normalized address to the addresses that name it.
+	aliases := map[Address][]Address{}
+	for _, a := range addresses {
+		if _, ok := results[a]; ok {
+			continue
+		}
+		results[a] = ContractResult{}
+
+		n := a.normalize()
+		if _, ok := aliases[n]; !ok {
+			unique = append(unique, n)
+		}
+		aliases[n] = append(aliases[n], a)
+	}
+
+	var mu sync.Mutex
+	runBounded(len(unique), opts.Concurrency, func(i int) {
+		contract, err := c.GetContract(ctx, unique[i].String())
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, a := range aliases[unique[i]] {
+			results[a] = ContractResult{Contract: contract, Err: err}
+		}
+	})
+
+	return results
+}
+
+// runBounded calls fn for 0..n-1 with at most limit calls running at once.
+func runBounded(n, limit int, fn func(i int)) {
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,211 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting the API while the circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every request with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through to
+	// find out whether the API has recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig configures a CircuitBreaker. Zero fields take the defaults
+// noted below.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens
+	// the circuit. Defaults to 5.
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before letting probes
+	// through. Defaults to 30s.
+	Cooldown time.Duration
+	// HalfOpenProbes is the number of requests let through at once while
+	// half-open. The first success closes the circuit and any failure opens
+	// it again. Defaults to 1.
+	HalfOpenProbes int
+	// IsFailure reports whether the error of a request counts as a failure.
+	// By default transport errors, timeouts and 5xx responses do; client
+	// errors and cancellation by the caller do not.
+	IsFailure func(err error) bool
+	// OnStateChange, if set, is called after every transition.
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitBreaker stops requests to the API after repeated failures so
+// callers fail fast during an outage instead of waiting on timeouts. It is
+// safe for concurrent use and may be shared by several clients.
+type CircuitBreaker struct {
+	cfg BreakerConfig
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probes   int
+	// gen counts state transitions. Requests carry the generation they were
+	// allowed in, so results from an earlier state are ignored.
+	gen uint64
+}
+
+// NewCircuitBreaker returns a closed CircuitBreaker.
+func NewCircuitBreaker(cfg BreakerConfig) *CircuitBreaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = 30 * time.Second
+	}
+	if cfg.HalfOpenProbes <= 0 {
+		cfg.HalfOpenProbes = 1
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = isBackendFailure
+	}
+	return &CircuitBreaker{cfg: cfg}
+}
+
+// WithCircuitBreaker guards every request of the client, retries included,
+// with breaker.
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.cfg.Cooldown {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a request may be sent. Every allowed request must
+// be followed by a call to done with the returned generation.
+func (b *CircuitBreaker) allow() (uint64, error) {
+	b.mu.Lock()
+	from := b.state
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.cfg.Cooldown {
+			b.mu.Unlock()
+			return 0, ErrCircuitOpen
+		}
+		b.setState(CircuitHalfOpen)
+		b.probes = 0
+		fallthrough
+	case CircuitHalfOpen:
+		if b.probes >= b.cfg.HalfOpenProbes {
+			b.mu.Unlock()
+			return 0, ErrCircuitOpen
+		}
+		b.probes++
+	}
+
+	to, gen := b.state, b.gen
+	b.mu.Unlock()
+	b.notify(from, to)
+	return gen, nil
+}
+
+// done records the outcome of a request let through by allow in generation
+// gen. Results of requests allowed before the last transition, such as a
+// slow request sent before the circuit opened, are ignored: they neither
+// count as probes nor move the breaker.
+func (b *CircuitBreaker) done(gen uint64, err error) {
+	b.mu.Lock()
+	if gen != b.gen {
+		b.mu.Unlock()
+		return
+	}
+	from := b.state
+
+	failed := err != nil && b.cfg.IsFailure(err)
+	switch b.state {
+	case CircuitClosed:
+		if !failed {
+			b.failures = 0
+			break
+		}
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.open()
+		}
+	case CircuitHalfOpen:
+		b.probes--
+		switch {
+		case failed:
+			b.open()
+		case !errors.Is(err, context.Canceled):
+			// Any answer that is not a failure shows the API is back.
+			b.setState(CircuitClosed)
+			b.failures = 0
+		}
+	}
+
+	to := b.state
+	b.mu.Unlock()
+	b.notify(from, to)
+}
+
+func (b *CircuitBreaker) open() {
+	b.setState(CircuitOpen)
+	b.openedAt = time.Now()
+	b.failures = 0
+	b.probes = 0
+}
+
+func (b *CircuitBreaker) setState(s CircuitState) {
+	b.state = s
+	b.gen++
+}
+
+func (b *CircuitBreaker) notify(from, to CircuitState) {
+	if from != to && b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(from, to)
+	}
+}
+
+// isBackendFailure is the default BreakerConfig.IsFailure.
+func isBackendFailure(err error) bool {
+	var se *streamError
+	if errors.Is(err, context.Canceled) || errors.As(err, &se) {
+		return false
+	}
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return ae.StatusCode >= 500
+	}
+	return true
+}
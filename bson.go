@@ -0,0 +1,85 @@
+package opensea
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// The value types of the package implement MarshalBSONValue and
+// UnmarshalBSONValue with the signatures of the mongo-driver v2
+// bson.ValueMarshaler and bson.ValueUnmarshaler interfaces, without
+// depending on the driver. These helpers encode the few BSON element types
+// they use.
+
+// BSON element types.
+const (
+	bsonDouble   byte = 0x01
+	bsonString   byte = 0x02
+	bsonBool     byte = 0x08
+	bsonDateTime byte = 0x09
+	bsonNull     byte = 0x0A
+	bsonInt32    byte = 0x10
+	bsonInt64    byte = 0x12
+)
+
+// marshalBSONString encodes s as a BSON string value.
+func marshalBSONString(s string) (byte, []byte, error) {
+	b := make([]byte, 4, 4+len(s)+1)
+	binary.LittleEndian.PutUint32(b, uint32(len(s)+1))
+	b = append(b, s...)
+	b = append(b, 0)
+	return bsonString, b, nil
+}
+
+// unmarshalBSONString decodes a BSON string value. A null value decodes to
+// the empty string.
+func unmarshalBSONString(typ byte, data []byte) (string, error) {
+	switch typ {
+	case bsonNull:
+		return "", nil
+	case bsonString:
+	default:
+		return "", fmt.Errorf("cannot decode BSON type 0x%02x as a string", typ)
+	}
+	if len(data) < 5 {
+		return "", fmt.Errorf("BSON string too short")
+	}
+	n := int(binary.LittleEndian.Uint32(data))
+	if n < 1 || len(data) < 4+n || data[4+n-1] != 0 {
+		return "", fmt.Errorf("malformed BSON string")
+	}
+	return string(data[4 : 4+n-1]), nil
+}
+
+// marshalBSONDouble encodes f as a BSON double value.
+func marshalBSONDouble(f float64) (byte, []byte, error) {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(f))
+	return bsonDouble, b, nil
+}
+
+// unmarshalBSONNumber returns the decimal text of a BSON double, int32 or
+// int64 value, and false for any other type.
+func unmarshalBSONNumber(typ byte, data []byte) (string, bool, error) {
+	switch typ {
+	case bsonDouble:
+		if len(data) != 8 {
+			return "", true, fmt.Errorf("malformed BSON double")
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(data))
+		return strconv.FormatFloat(f, 'f', -1, 64), true, nil
+	case bsonInt32:
+		if len(data) != 4 {
+			return "", true, fmt.Errorf("malformed BSON int32")
+		}
+		return strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(data))), 10), true, nil
+	case bsonInt64:
+		if len(data) != 8 {
+			return "", true, fmt.Errorf("malformed BSON int64")
+		}
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(data)), 10), true, nil
+	}
+	return "", false, nil
+}
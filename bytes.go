@@ -0,0 +1,172 @@
+package opensea
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidBytes is returned when decoding a malformed hex string.
+var ErrInvalidBytes = errors.New("invalid hex bytes")
+
+// ErrBytesLength is returned when a byte string has the wrong length.
+var ErrBytesLength = errors.New("wrong byte length")
+
+// abiWordSize is the size of an ABI-encoded word.
+const abiWordSize = 32
+
+// abiSelectorSize is the size of the function selector that starts calldata.
+const abiSelectorSize = 4
+
+// Bytes is a byte string such as calldata or a signature component. It
+// encodes as a "0x"-prefixed hex string and decodes hex with or without the
+// prefix.
+type Bytes []byte
+
+// ParseBytes decodes a hex string with or without a "0x" prefix.
+func ParseBytes(s string) (Bytes, error) {
+	h := s
+	if len(h) >= 2 && h[0] == '0' && (h[1] == 'x' || h[1] == 'X') {
+		h = h[2:]
+	}
+	if h == "" {
+		return nil, nil
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidBytes, s)
+	}
+	return b, nil
+}
+
+// String returns b as a "0x"-prefixed hex string, like Hex, so that binary
+// data prints legibly with %s and %v.
+func (b Bytes) String() string {
+	return b.Hex()
+}
+
+// Hex returns b as a lowercase "0x"-prefixed hex string.
+func (b Bytes) Hex() string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// CheckLen returns an error wrapping ErrBytesLength unless b is n bytes
+// long.
+func (b Bytes) CheckLen(n int) error {
+	if len(b) != n {
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrBytesLength, len(b), n)
+	}
+	return nil
+}
+
+// Selector returns the 4-byte function selector at the start of calldata.
+func (b Bytes) Selector() (Bytes, error) {
+	if len(b) < abiSelectorSize {
+		return nil, fmt.Errorf("%w: calldata of %d bytes has no selector", ErrBytesLength, len(b))
+	}
+	return b[:abiSelectorSize], nil
+}
+
+// Args returns the ABI-encoded arguments that follow the function selector
+// in calldata.
+func (b Bytes) Args() (Bytes, error) {
+	if _, err := b.Selector(); err != nil {
+		return nil, err
+	}
+	args := b[abiSelectorSize:]
+	if len(args)%abiWordSize != 0 {
+		return nil, fmt.Errorf("%w: %d argument bytes are not word-aligned", ErrBytesLength, len(args))
+	}
+	return args, nil
+}
+
+// Words splits b into 32-byte ABI words. len(b) must be a multiple of 32.
+func (b Bytes) Words() ([]Bytes, error) {
+	if len(b)%abiWordSize != 0 {
+		return nil, fmt.Errorf("%w: %d bytes are not word-aligned", ErrBytesLength, len(b))
+	}
+	words := make([]Bytes, 0, len(b)/abiWordSize)
+	for i := 0; i < len(b); i += abiWordSize {
+		words = append(words, b[i:i+abiWordSize])
+	}
+	return words, nil
+}
+
+// Word returns the i'th 32-byte ABI word of b.
+func (b Bytes) Word(i int) (Bytes, error) {
+	if i < 0 || (i+1)*abiWordSize > len(b) {
+		return nil, fmt.Errorf("%w: word %d is out of range for %d bytes", ErrBytesLength, i, len(b))
+	}
+	return b[i*abiWordSize : (i+1)*abiWordSize], nil
+}
+
+// WordBig returns the i'th ABI word of b as an unsigned integer.
+func (b Bytes) WordBig(i int) (*big.Int, error) {
+	w, err := b.Word(i)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(w), nil
+}
+
+// WordAddress returns the i'th ABI word of b as an address, which occupies
+// the word's low 20 bytes.
+func (b Bytes) WordAddress(i int) (Address, error) {
+	w, err := b.Word(i)
+	if err != nil {
+		return NullAddress, err
+	}
+	return Address("0x" + hex.EncodeToString(w[abiWordSize-20:])), nil
+}
+
+// MarshalJSON encodes b as a "0x"-prefixed hex string. nil encodes as null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + b.Hex() + `"`), nil
+}
+
+// UnmarshalJSON decodes a hex string with or without a "0x" prefix. null
+// and "" decode to nil.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		*b = nil
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return fmt.Errorf("%w: %s is not a JSON string", ErrInvalidBytes, s)
+	}
+	v, err := ParseBytes(s[1 : len(s)-1])
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// MarshalBSONValue encodes b as a "0x"-prefixed hex BSON string. nil
+// encodes as BSON null.
+func (b Bytes) MarshalBSONValue() (byte, []byte, error) {
+	if b == nil {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONString(b.Hex())
+}
+
+// UnmarshalBSONValue decodes a hex BSON string or null.
+func (b *Bytes) UnmarshalBSONValue(typ byte, data []byte) error {
+	s, err := unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal bytes: %w", err)
+	}
+	v, err := ParseBytes(s)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
@@ -0,0 +1,309 @@
+package opensea
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Cache stores raw API responses. Implementations must be safe for
+// concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// CacheEntry is a cached response body along with its validator.
+type CacheEntry struct {
+	Body    []byte    `json:"body"`
+	ETag    string    `json:"etag,omitempty"`
+	Expires time.Time `json:"expires"`
+}
+
+// CacheConfig decides which responses are cached and for how long.
+type CacheConfig struct {
+	// DefaultTTL applies to endpoints without an entry in TTLs. Zero leaves
+	// them uncached.
+	DefaultTTL time.Duration
+	// TTLs maps endpoint path prefixes, such as "/api/v1/asset_contract", to
+	// the time their responses stay fresh. The longest matching prefix wins.
+	TTLs map[string]time.Duration
+}
+
+// DefaultCacheConfig caches assets, contracts and collections, which rarely
+// change, and nothing else.
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		TTLs: map[string]time.Duration{
+			assetEP + "/":    10 * time.Minute,
+			contractEP + "/": time.Hour,
+		},
+	}
+}
+
+// CacheStats counts cache lookups made by a client. Every lookup is either
+// a hit or a miss, as reported to Metrics.ObserveCache.
+type CacheStats struct {
+	// Hits counts lookups answered from the cache, either fresh or after
+	// the server confirmed an expired entry with a 304.
+	Hits uint64
+	// Misses counts lookups that needed a full response from the server,
+	// or failed.
+	Misses uint64
+	// Revalidations counts the hits that were confirmed with a 304.
+	Revalidations uint64
+}
+
+// WithCache caches responses in cache. Expired entries with an ETag are
+// revalidated with If-None-Match instead of being fetched again.
+func WithCache(cache Cache, config CacheConfig) Option {
+	return func(c *Client) {
+		c.cache = &responseCache{cache: cache, config: config}
+	}
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context that makes requests skip the cache lookup.
+// Fresh responses are still stored.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	b, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return b
+}
+
+// CacheStats returns the hit and miss counters of the client's cache.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Hits:          c.cache.hits.Load(),
+		Misses:        c.cache.misses.Load(),
+		Revalidations: c.cache.revalidations.Load(),
+	}
+}
+
+type responseCache struct {
+	cache  Cache
+	config CacheConfig
+
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	revalidations atomic.Uint64
+}
+
+func (rc *responseCache) ttl(path string) time.Duration {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	ttl, best := rc.config.DefaultTTL, -1
+	for prefix, d := range rc.config.TTLs {
+		if strings.HasPrefix(path, prefix) && len(prefix) > best {
+			ttl, best = d, len(prefix)
+		}
+	}
+	return ttl
+}
+
+func (c *Client) cacheTTL(path string) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+	return c.cache.ttl(path)
+}
+
+// getCached serves path from the cache while the entry is fresh and
+// revalidates it with its ETag once it has expired. header is sent with any
+// request that reaches the server.
+func (c *Client) getCached(ctx context.Context, path string, header http.Header, ttl time.Duration) (*response, error) {
+	rc := c.cache
+	key := c.baseURL + path
+
+	var etag string
+	entry, ok := rc.cache.Get(key)
+	if ok && !cacheBypassed(ctx) {
+		if time.Now().Before(entry.Expires) {
+			c.observeCache(ctx, true)
+			return &response{status: http.StatusOK, body: entry.Body}, nil
+		}
+		etag = entry.ETag
+	}
+
+	resp, err := c.fetch(ctx, path, header, etag, nil)
+	if err != nil {
+		c.observeCache(ctx, false)
+		return nil, err
+	}
+
+	if resp.status == http.StatusNotModified {
+		rc.revalidations.Add(1)
+		c.observeCache(ctx, true)
+		rc.cache.Set(key, &CacheEntry{Body: entry.Body, ETag: entry.ETag, Expires: time.Now().Add(ttl)})
+		resp.body = entry.Body
+		return resp, nil
+	}
+
+	c.observeCache(ctx, false)
+	rc.cache.Set(key, &CacheEntry{Body: resp.body, ETag: resp.header.Get("ETag"), Expires: time.Now().Add(ttl)})
+	return resp, nil
+}
+
+// observeCache counts a cache lookup in the client's stats and metrics.
+func (c *Client) observeCache(ctx context.Context, hit bool) {
+	if hit {
+		c.cache.hits.Add(1)
+	} else {
+		c.cache.misses.Add(1)
+	}
+	if c.metrics != nil {
+		c.metrics.ObserveCache(operationFrom(ctx), hit)
+	}
+}
+
+// LRUCache is an in-memory Cache holding up to a fixed number of entries and
+// evicting the least recently used one when full.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an LRUCache holding at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (l *LRUCache) Get(key string) (*CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	l.ll.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+func (l *LRUCache) Set(key string, entry *CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		l.ll.MoveToFront(el)
+		return
+	}
+
+	l.items[key] = l.ll.PushFront(&lruItem{key: key, entry: entry})
+	if l.ll.Len() > l.capacity {
+		oldest := l.ll.Back()
+		l.ll.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		l.ll.Remove(el)
+		delete(l.items, key)
+	}
+}
+
+// Len returns the number of cached entries.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ll.Len()
+}
+
+// DiskCache is a Cache storing one JSON file per entry in a directory, so
+// cached responses survive restarts.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache rooted at dir, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := new(CacheEntry)
+	if err := json.Unmarshal(b, entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so readers never see a partial entry.
+	tmp, err := os.CreateTemp(d.dir, "entry-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
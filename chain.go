@@ -0,0 +1,169 @@
+package opensea
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedChain is returned for a chain OpenSea does not support.
+var ErrUnsupportedChain = errors.New("unsupported chain")
+
+// Chain is a blockchain identifier as used in OpenSea API paths.
+type Chain string
+
+// Mainnets
+const (
+	ChainEthereum     Chain = "ethereum"
+	ChainPolygon      Chain = "matic"
+	ChainArbitrum     Chain = "arbitrum"
+	ChainArbitrumNova Chain = "arbitrum_nova"
+	ChainOptimism     Chain = "optimism"
+	ChainBase         Chain = "base"
+	ChainAvalanche    Chain = "avalanche"
+	ChainKlaytn       Chain = "klaytn"
+	ChainZora         Chain = "zora"
+	ChainBlast        Chain = "blast"
+	ChainSolana       Chain = "solana"
+	ChainBSC          Chain = "bsc"
+	ChainApeChain     Chain = "ape_chain"
+	ChainFlow         Chain = "flow"
+	ChainB3           Chain = "b3"
+	ChainRonin        Chain = "ronin"
+	ChainShape        Chain = "shape"
+	ChainUnichain     Chain = "unichain"
+	ChainSei          Chain = "sei"
+	ChainAbstract     Chain = "abstract"
+)
+
+// Testnets
+const (
+	ChainSepolia         Chain = "sepolia"
+	ChainAmoy            Chain = "amoy"
+	ChainBaobab          Chain = "baobab"
+	ChainBaseSepolia     Chain = "base_sepolia"
+	ChainBlastSepolia    Chain = "blast_sepolia"
+	ChainArbitrumSepolia Chain = "arbitrum_sepolia"
+	ChainAvalancheFuji   Chain = "avalanche_fuji"
+	ChainOptimismSepolia Chain = "optimism_sepolia"
+	ChainSolanaDevnet    Chain = "solana_devnet"
+	ChainZoraSepolia     Chain = "zora_sepolia"
+)
+
+// chains maps every supported chain to whether it is a testnet.
+var chains = map[Chain]bool{
+	ChainEthereum:     false,
+	ChainPolygon:      false,
+	ChainArbitrum:     false,
+	ChainArbitrumNova: false,
+	ChainOptimism:     false,
+	ChainBase:         false,
+	ChainAvalanche:    false,
+	ChainKlaytn:       false,
+	ChainZora:         false,
+	ChainBlast:        false,
+	ChainSolana:       false,
+	ChainBSC:          false,
+	ChainApeChain:     false,
+	ChainFlow:         false,
+	ChainB3:           false,
+	ChainRonin:        false,
+	ChainShape:        false,
+	ChainUnichain:     false,
+	ChainSei:          false,
+	ChainAbstract:     false,
+
+	ChainSepolia:         true,
+	ChainAmoy:            true,
+	ChainBaobab:          true,
+	ChainBaseSepolia:     true,
+	ChainBlastSepolia:    true,
+	ChainArbitrumSepolia: true,
+	ChainAvalancheFuji:   true,
+	ChainOptimismSepolia: true,
+	ChainSolanaDevnet:    true,
+	ChainZoraSepolia:     true,
+}
+
+// chainAliases are common names accepted by ParseChain.
+var chainAliases = map[string]Chain{
+	"eth":      ChainEthereum,
+	"mainnet":  ChainEthereum,
+	"polygon":  ChainPolygon,
+	"avax":     ChainAvalanche,
+	"kaia":     ChainKlaytn,
+	"arb":      ChainArbitrum,
+	"op":       ChainOptimism,
+	"bnb":      ChainBSC,
+	"fuji":     ChainAvalancheFuji,
+	"kairos":   ChainBaobab,
+	"mumbai":   ChainAmoy,
+	"apechain": ChainApeChain,
+}
+
+// ParseChain parses a chain identifier, case-insensitively, also accepting
+// common aliases such as "polygon" and "avax".
+func ParseChain(s string) (Chain, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if c, ok := chainAliases[name]; ok {
+		return c, nil
+	}
+	c := Chain(name)
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	return c, nil
+}
+
+// Chains returns every supported chain.
+func Chains() []Chain {
+	ret := make([]Chain, 0, len(chains))
+	for c := range chains {
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+func (c Chain) String() string {
+	return string(c)
+}
+
+// Valid reports whether OpenSea supports the chain.
+func (c Chain) Valid() bool {
+	_, ok := chains[c]
+	return ok
+}
+
+// Validate returns an error wrapping ErrUnsupportedChain if c is not valid.
+func (c Chain) Validate() error {
+	if !c.Valid() {
+		return fmt.Errorf("%w: %q", ErrUnsupportedChain, string(c))
+	}
+	return nil
+}
+
+// IsTestnet reports whether the chain is a test network.
+func (c Chain) IsTestnet() bool {
+	return chains[c]
+}
+
+// WithChain sets the chain used when a method is given an empty chain, and
+// recorded on models returned by the chain-less v1 endpoints. It defaults to
+// ChainEthereum.
+func WithChain(chain Chain) Option {
+	return func(c *Client) {
+		c.chain = chain
+	}
+}
+
+// resolveChain falls back to the client's chain for an empty chain and
+// validates the result.
+func (c *Client) resolveChain(chain Chain) (Chain, error) {
+	if chain == "" {
+		chain = c.chain
+	}
+	if err := chain.Validate(); err != nil {
+		return "", err
+	}
+	return chain, nil
+}
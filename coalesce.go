@@ -0,0 +1,98 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// WithCoalescing makes concurrent identical GET requests share a single
+// upstream call. Requests are identical when their path and query match;
+// the headers of the first caller are the ones sent. Every caller gets its
+// own copy of the response.
+func WithCoalescing() Option {
+	return func(c *Client) {
+		c.flights = &flightGroup{}
+	}
+}
+
+type noCoalesceKey struct{}
+
+// NoCoalesce returns a context that makes requests skip coalescing and always
+// go upstream on their own.
+func NoCoalesce(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCoalesceKey{}, true)
+}
+
+func coalescingDisabled(ctx context.Context) bool {
+	b, _ := ctx.Value(noCoalesceKey{}).(bool)
+	return b
+}
+
+// flightGroup tracks the calls in flight by key.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	resp *Response
+	err  error
+}
+
+// do runs fn for key unless a call for key is already in flight, in which
+// case it waits for that call's result. shared reports whether the result
+// came from another caller's call.
+//
+// A follower whose leader was cancelled, while its own context is still
+// live, runs fn itself rather than inheriting a cancellation that was not
+// its own.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (*Response, error)) (resp *Response, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*flight{}
+	}
+	if f, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err(), false
+		}
+		if errors.Is(f.err, context.Canceled) || errors.Is(f.err, context.DeadlineExceeded) {
+			resp, err := fn()
+			return resp, err, false
+		}
+		return f.resp.clone(), f.err, true
+	}
+
+	f := &flight{done: make(chan struct{})}
+	g.calls[key] = f
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(f.done)
+	}()
+
+	f.resp, f.err = fn()
+	return f.resp.clone(), f.err, false
+}
+
+// clone returns a deep copy of r, so callers sharing a response cannot see
+// each other's modifications.
+func (r *Response) clone() *Response {
+	if r == nil {
+		return nil
+	}
+	return &Response{
+		StatusCode: r.StatusCode,
+		Header:     r.Header.Clone(),
+		Body:       slices.Clone(r.Body),
+	}
+}
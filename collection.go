@@ -0,0 +1,133 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CollectionDetails is a collection as returned by the v2 API.
+type CollectionDetails struct {
+	Collection              string               `json:"collection" bson:"collection"`
+	Name                    string               `json:"name" bson:"name"`
+	Description             string               `json:"description" bson:"description"`
+	ImageURL                string               `json:"image_url" bson:"image_url"`
+	BannerImageURL          string               `json:"banner_image_url" bson:"banner_image_url"`
+	Owner                   Address              `json:"owner" bson:"owner"`
+	SafelistStatus          string               `json:"safelist_status" bson:"safelist_status"`
+	Category                string               `json:"category" bson:"category"`
+	IsDisabled              bool                 `json:"is_disabled" bson:"is_disabled"`
+	IsNSFW                  bool                 `json:"is_nsfw" bson:"is_nsfw"`
+	TraitOffersEnabled      bool                 `json:"trait_offers_enabled" bson:"trait_offers_enabled"`
+	CollectionOffersEnabled bool                 `json:"collection_offers_enabled" bson:"collection_offers_enabled"`
+	OpenseaURL              string               `json:"opensea_url" bson:"opensea_url"`
+	ProjectURL              string               `json:"project_url" bson:"project_url"`
+	WikiURL                 string               `json:"wiki_url" bson:"wiki_url"`
+	DiscordURL              string               `json:"discord_url" bson:"discord_url"`
+	TelegramURL             string               `json:"telegram_url" bson:"telegram_url"`
+	TwitterUsername         string               `json:"twitter_username" bson:"twitter_username"`
+	InstagramUsername       string               `json:"instagram_username" bson:"instagram_username"`
+	Contracts               []CollectionContract `json:"contracts" bson:"contracts"`
+	Editors                 []Address            `json:"editors" bson:"editors"`
+	Fees                    []CollectionFee      `json:"fees" bson:"fees"`
+	TotalSupply             int64                `json:"total_supply" bson:"total_supply"`
+	CreatedDate             Timestamp            `json:"created_date" bson:"created_date"`
+	PaymentTokens           []CollectionToken    `json:"payment_tokens" bson:"payment_tokens"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionContract is a contract belonging to a collection.
+type CollectionContract struct {
+	Address Address `json:"address" bson:"address"`
+	Chain   Chain   `json:"chain" bson:"chain"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionFee is a fee charged on sales in a collection. Fee is a
+// percent; BasisPoints converts it.
+type CollectionFee struct {
+	Fee       float64 `json:"fee" bson:"fee"` // percent, e.g. 2.5
+	Recipient Address `json:"recipient" bson:"recipient"`
+	Required  bool    `json:"required" bson:"required"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionToken is a token accepted as payment in a collection.
+type CollectionToken struct {
+	Symbol   string  `json:"symbol" bson:"symbol"`
+	Address  Address `json:"address" bson:"address"`
+	Chain    Chain   `json:"chain" bson:"chain"`
+	Image    string  `json:"image" bson:"image"`
+	Name     string  `json:"name" bson:"name"`
+	Decimals int64   `json:"decimals" bson:"decimals"`
+	EthPrice string  `json:"eth_price" bson:"eth_price"`
+	UsdPrice string  `json:"usd_price" bson:"usd_price"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionStats holds the all-time and per-interval statistics of a
+// collection.
+type CollectionStats struct {
+	Total     CollectionTotalStats      `json:"total" bson:"total"`
+	Intervals []CollectionIntervalStats `json:"intervals" bson:"intervals"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionTotalStats are the all-time statistics of a collection.
+type CollectionTotalStats struct {
+	Volume           float64 `json:"volume" bson:"volume"`
+	Sales            float64 `json:"sales" bson:"sales"`
+	AveragePrice     float64 `json:"average_price" bson:"average_price"`
+	NumOwners        int64   `json:"num_owners" bson:"num_owners"`
+	MarketCap        float64 `json:"market_cap" bson:"market_cap"`
+	FloorPrice       float64 `json:"floor_price" bson:"floor_price"`
+	FloorPriceSymbol string  `json:"floor_price_symbol" bson:"floor_price_symbol"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// CollectionIntervalStats are the statistics of a collection over an
+// interval such as one_day, seven_day or thirty_day.
+type CollectionIntervalStats struct {
+	Interval     string  `json:"interval" bson:"interval"`
+	Volume       float64 `json:"volume" bson:"volume"`
+	VolumeDiff   float64 `json:"volume_diff" bson:"volume_diff"`
+	VolumeChange float64 `json:"volume_change" bson:"volume_change"`
+	Sales        float64 `json:"sales" bson:"sales"`
+	SalesDiff    float64 `json:"sales_diff" bson:"sales_diff"`
+	AveragePrice float64 `json:"average_price" bson:"average_price"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// GetCollection retrieves the details of a collection by its slug.
+func (c *Client) GetCollection(ctx context.Context, slug string) (*CollectionDetails, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+
+	var collection CollectionDetails
+	if err := c.getJSON(ctx, "GetCollection", fmt.Sprintf(collectionV2EP, url.PathEscape(slug)), &collection); err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	return &collection, nil
+}
+
+// GetCollectionStats retrieves the statistics of a collection by its slug.
+func (c *Client) GetCollectionStats(ctx context.Context, slug string) (*CollectionStats, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+
+	var stats CollectionStats
+	if err := c.getJSON(ctx, "GetCollectionStats", fmt.Sprintf(collectionStatsV2EP, url.PathEscape(slug)), &stats); err != nil {
+		return nil, fmt.Errorf("failed to get collection stats: %w", err)
+	}
+	return &stats, nil
+}
@@ -4,10 +4,34 @@ const (
 	// API endpoints
 	mainnetAPI = "https://api.opensea.io"
 	testnetAPI = "https://testnets-api.opensea.io"
-	rinkebyAPI = "https://rinkeby-api.opensea.io"
+
+	// Website hosts, for permalinks
+	mainnetWeb = "https://opensea.io"
+	testnetWeb = "https://testnets.opensea.io"
 
 	// Resource endpoints
-	contractEP = "/api/v1/asset_contract"
-	assetEP    = "/api/v1/asset"
-	musicEP    = "/api/v1/assets"
+	basePath               = "/api/v1"
+	contractEP             = basePath + "/asset_contract"
+	assetEP                = basePath + "/asset"
+	assetsEP               = basePath + "/assets"
+	musicEP                = assetsEP
+	eventsEP               = basePath + "/events/"
+	ordersEP               = "/wyvern/v1/orders"
+	singleAssetEndpoint    = assetEP
+	singleContractEndpoint = contractEP
+
+	// v2 resource endpoints, formatted with fmt.Sprintf
+	basePathV2             = "/api/v2"
+	chainNFTV2EP           = basePathV2 + "/chain/%s/contract/%s/nfts/%s"
+	chainContractV2EP      = basePathV2 + "/chain/%s/contract/%s"
+	contractNFTsV2EP       = basePathV2 + "/chain/%s/contract/%s/nfts"
+	accountNFTsV2EP        = basePathV2 + "/chain/%s/account/%s/nfts"
+	collectionNFTsV2EP     = basePathV2 + "/collection/%s/nfts"
+	collectionV2EP         = basePathV2 + "/collections/%s"
+	collectionStatsV2EP    = basePathV2 + "/collections/%s/stats"
+	collectionEventsV2EP   = basePathV2 + "/events/collection/%s"
+	accountEventsV2EP      = basePathV2 + "/events/accounts/%s"
+	nftEventsV2EP          = basePathV2 + "/events/chain/%s/contract/%s/nfts/%s"
+	collectionListingsV2EP = basePathV2 + "/listings/collection/%s/all"
+	collectionOffersV2EP   = basePathV2 + "/offers/collection/%s/all"
 )
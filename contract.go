@@ -11,7 +11,7 @@ type AssetContract struct {
 	Collection     Collection `json:"collection" bson:"collection"`
 	Address        Address    `json:"address" bson:"address"`
 	ContractType   string     `json:"asset_contract_type" bson:"asset_contract_type"`
-	CreatedDate    string     `json:"created_date" bson:"created_date"`
+	CreatedDate    Timestamp  `json:"created_date" bson:"created_date"`
 	Name           string     `json:"name" bson:"name"`
 	NFTVersion     string     `json:"nft_version" bson:"nft_version"`
 	OpenseaVersion any        `json:"opensea_version" bson:"opensea_version"`
@@ -24,15 +24,19 @@ type AssetContract struct {
 	ImageURL       string     `json:"image_url" bson:"image_url"`
 
 	// Fee configuration
-	DefaultToFiat              bool    `json:"default_to_fiat" bson:"default_to_fiat"`
-	DevBuyerFeeBasisPoints     int64   `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints    int64   `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
-	OnlyProxiedTransfers       bool    `json:"only_proxied_transfers" bson:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints int64   `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoint int64   `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
-	BuyerFeeBasisPoints        int64   `json:"buyer_fee_basis_points" bson:"buyer_fee_basis_points"`
-	SellerFeeBasisPoints       int64   `json:"seller_fee_basis_points" bson:"seller_fee_basis_points"`
-	PayoutAddress              Address `json:"payout_address" bson:"payout_address"`
+	DefaultToFiat               bool        `json:"default_to_fiat" bson:"default_to_fiat"`
+	DevBuyerFeeBasisPoints      BasisPoints `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
+	DevSellerFeeBasisPoints     BasisPoints `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
+	OnlyProxiedTransfers        bool        `json:"only_proxied_transfers" bson:"only_proxied_transfers"`
+	OpenseaBuyerFeeBasisPoints  BasisPoints `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
+	OpenseaSellerFeeBasisPoints BasisPoints `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
+	BuyerFeeBasisPoints         BasisPoints `json:"buyer_fee_basis_points" bson:"buyer_fee_basis_points"`
+	SellerFeeBasisPoints        BasisPoints `json:"seller_fee_basis_points" bson:"seller_fee_basis_points"`
+	PayoutAddress               Address     `json:"payout_address" bson:"payout_address"`
+
+	Chain Chain `json:"chain,omitempty" bson:"chain,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 // GetContract retrieves a single contract by its address
@@ -42,7 +46,7 @@ func (c *Client) GetContract(ctx context.Context, contractAddress string) (*Asse
 	}
 
 	path := fmt.Sprintf("%s/%s", singleContractEndpoint, contractAddress)
-	resp, err := c.get(ctx, path)
+	resp, err := c.get(ctx, "GetContract", path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get contract: %w", err)
 	}
@@ -51,6 +55,9 @@ func (c *Client) GetContract(ctx context.Context, contractAddress string) (*Asse
 	if err := json.Unmarshal(resp, &contract); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal contract: %w", err)
 	}
+	if contract.Chain == "" {
+		contract.Chain = c.chain
+	}
 
 	return &contract, nil
 }
@@ -0,0 +1,413 @@
+package opensea
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDecimal is returned when parsing a malformed decimal number.
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// ErrDivisionByZero is returned by Decimal.Quo for a zero divisor.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// ErrDecimalRange is returned when the scale of a result does not fit in an
+// int32.
+var ErrDecimalRange = errors.New("decimal out of range")
+
+// Decimal is an exact decimal number: an arbitrary-precision integer scaled
+// by a power of ten. The zero value is 0. Decimals are immutable; every
+// operation returns a new value.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32 // value = unscaled * 10^-scale
+}
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalScale bounds the scale ParseDecimal accepts, and so its
+// exponent. Without it a short input such as "1e-2000000000" would make
+// later arithmetic allocate gigabytes; amounts never need more.
+const maxDecimalScale = 1000
+
+// addScale returns a+b as a scale, or an error wrapping ErrDecimalRange if
+// the sum does not fit in an int32.
+func addScale(a, b int64) (int32, error) {
+	s := a + b
+	if s > math.MaxInt32 || s < math.MinInt32 {
+		return 0, fmt.Errorf("%w: scale %d", ErrDecimalRange, s)
+	}
+	return int32(s), nil
+}
+
+// NewDecimal returns unscaled * 10^-scale.
+func NewDecimal(unscaled *big.Int, scale int32) Decimal {
+	if unscaled == nil {
+		return Decimal{}
+	}
+	return Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// DecimalFromInt returns i as a Decimal.
+func DecimalFromInt(i int64) Decimal {
+	return Decimal{unscaled: big.NewInt(i)}
+}
+
+// ParseDecimal parses a decimal number such as "12", "-0.015" or "1.5e18".
+// Numbers needing more than 1000 digits after the point, or an exponent
+// beyond 1000 in either direction, are out of range.
+func ParseDecimal(s string) (Decimal, error) {
+	in := s
+	s = strings.TrimSpace(s)
+
+	exp := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, in)
+		}
+		exp, s = e, s[:i]
+	}
+
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg, s = s[0] == '-', s[1:]
+	}
+	intPart, frac, _ := strings.Cut(s, ".")
+	digits := intPart + frac
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, in)
+	}
+
+	v, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		v.Neg(v)
+	}
+	scale := int64(len(frac)) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w: %q is out of range", ErrInvalidDecimal, in)
+	}
+	return Decimal{unscaled: v, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal is ParseDecimal for constants known to be valid. It
+// panics on error.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+// rescale returns the unscaled value of d at a scale at least d.scale.
+func (d Decimal) rescale(scale int32) *big.Int {
+	v := new(big.Int).Set(d.int())
+	if scale > d.scale {
+		v.Mul(v, pow10(int64(scale)-int64(d.scale)))
+	}
+	return v
+}
+
+// Add returns d + e.
+func (d Decimal) Add(e Decimal) Decimal {
+	scale := max(d.scale, e.scale)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), e.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - e.
+func (d Decimal) Sub(e Decimal) Decimal {
+	return d.Add(e.Neg())
+}
+
+// Mul returns d * e. It fails with ErrDecimalRange if the scale of the
+// product does not fit in an int32.
+func (d Decimal) Mul(e Decimal) (Decimal, error) {
+	scale, err := addScale(int64(d.scale), int64(e.scale))
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), e.int()), scale: scale}, nil
+}
+
+// Quo returns d / e rounded half away from zero to places decimal places.
+func (d Decimal) Quo(e Decimal, places int32) (Decimal, error) {
+	if e.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+
+	// d/e = d.unscaled * 10^(e.scale+places-d.scale) / e.unscaled, in units
+	// of 10^-places.
+	num := new(big.Int).Set(d.int())
+	den := new(big.Int).Set(e.int())
+	if shift := int64(e.scale) + int64(places) - int64(d.scale); shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{unscaled: quoRound(num, den), scale: places}, nil
+}
+
+// quoRound returns num/den rounded half away from zero.
+func quoRound(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	r2 := new(big.Int).Abs(r)
+	r2.Lsh(r2, 1)
+	if r2.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Sign returns -1, 0 or 1 as d is negative, zero or positive.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp returns -1, 0 or 1 as d is less than, equal to or greater than e.
+func (d Decimal) Cmp(e Decimal) int {
+	scale := max(d.scale, e.scale)
+	return d.rescale(scale).Cmp(e.rescale(scale))
+}
+
+// Equal reports whether d and e are the same number, whatever their scale.
+func (d Decimal) Equal(e Decimal) bool {
+	return d.Cmp(e) == 0
+}
+
+// Shift returns d * 10^n exactly. It fails with ErrDecimalRange if the
+// resulting scale does not fit in an int32.
+func (d Decimal) Shift(n int32) (Decimal, error) {
+	scale, err := addScale(int64(d.scale), -int64(n))
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{unscaled: new(big.Int).Set(d.int()), scale: scale}, nil
+}
+
+// Round returns d rounded half away from zero to places decimal places.
+func (d Decimal) Round(places int32) Decimal {
+	if places >= d.scale {
+		return d
+	}
+	return Decimal{unscaled: quoRound(d.int(), pow10(int64(d.scale)-int64(places))), scale: places}
+}
+
+// BigInt returns the integer part of d and whether d has no fractional part.
+func (d Decimal) BigInt() (*big.Int, bool) {
+	if d.scale <= 0 {
+		return d.rescale(0), true
+	}
+	q, r := new(big.Int).QuoRem(d.int(), pow10(int64(d.scale)), new(big.Int))
+	return q, r.Sign() == 0
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.ratParts()).Float64()
+	return f
+}
+
+func (d Decimal) ratParts() (*big.Int, *big.Int) {
+	if d.scale <= 0 {
+		return d.rescale(0), big.NewInt(1)
+	}
+	return d.int(), pow10(int64(d.scale))
+}
+
+// String returns d in plain notation without trailing fractional zeros,
+// e.g. "1.5" or "-200".
+func (d Decimal) String() string {
+	s := d.StringFixed(max(d.scale, 0))
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// StringFixed returns d rounded to exactly places decimal places.
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	v := d.Round(places).rescale(places)
+
+	neg := v.Sign() < 0
+	digits := v.Abs(v).String()
+	if places > 0 {
+		if pad := int(places) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(places)] + "." + digits[len(digits)-int(places):]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// FromWei converts an amount in a token's smallest unit to whole tokens of a
+// token with the given number of decimals, e.g. wei to ETH for 18.
+// decimals must be in [0, 1000].
+func FromWei(wei Decimal, decimals int64) (Decimal, error) {
+	if err := checkTokenDecimals(decimals); err != nil {
+		return Decimal{}, err
+	}
+	return wei.Shift(-int32(decimals))
+}
+
+// ToWei converts an amount of whole tokens to the token's smallest unit. It
+// fails if the amount is finer than the token's precision. decimals must be
+// in [0, 1000].
+func ToWei(units Decimal, decimals int64) (*big.Int, error) {
+	if err := checkTokenDecimals(decimals); err != nil {
+		return nil, err
+	}
+	wei, err := units.Shift(int32(decimals))
+	if err != nil {
+		return nil, err
+	}
+	v, exact := wei.BigInt()
+	if !exact {
+		return nil, fmt.Errorf("%s has more than %d decimal places", units, decimals)
+	}
+	return v, nil
+}
+
+func checkTokenDecimals(decimals int64) error {
+	if decimals < 0 || decimals > maxDecimalScale {
+		return fmt.Errorf("token decimals %d out of range [0, %d]", decimals, maxDecimalScale)
+	}
+	return nil
+}
+
+// FormatETH formats an amount of ether for display, with at most four
+// decimal places, e.g. "1.2346 ETH".
+func FormatETH(eth Decimal) string {
+	return eth.Round(4).String() + " ETH"
+}
+
+// FormatUSD formats an amount of dollars for display with thousands
+// separators and cents, e.g. "$1,234.50" or "-$0.25".
+func FormatUSD(usd Decimal) string {
+	s := usd.StringFixed(2)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	return sign + "$" + b.String() + "." + frac
+}
+
+// MarshalJSON encodes d as a JSON string, so no precision is lost to
+// float64 in other decoders.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a JSON string or number. null decodes to 0.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s, err := unquoteNumber(b)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal decimal: %w", err)
+	}
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalBSONValue encodes d as a BSON string.
+func (d Decimal) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONString(d.String())
+}
+
+// UnmarshalBSONValue decodes a BSON string.
+func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	s, err := unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal decimal: %w", err)
+	}
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// unquoteNumber returns the text of a JSON string or number literal, or ""
+// for null.
+func unquoteNumber(b []byte) (string, error) {
+	b = []byte(strings.TrimSpace(string(b)))
+	switch {
+	case string(b) == "null":
+		return "", nil
+	case len(b) > 0 && b[0] == '"':
+		var s string
+		err := json.Unmarshal(b, &s)
+		return s, err
+	default:
+		var n json.Number
+		if err := json.Unmarshal(b, &n); err != nil {
+			return "", err
+		}
+		return n.String(), nil
+	}
+}
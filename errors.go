@@ -1,6 +1,104 @@
 package opensea
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 // ErrEmptyContractAddress is returned when attempting to get a contract with an empty address
 var ErrEmptyContractAddress = errors.New("contract address cannot be empty")
+
+// Sentinel errors matched by APIError through errors.Is.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrNotFound     = errors.New("not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// APIError is returned for every non-200 response from the OpenSea API.
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+	Body       []byte
+	Header     http.Header
+	// Messages holds the error messages OpenSea put in the response body,
+	// if any could be parsed.
+	Messages []string
+}
+
+func newAPIError(endpoint string, statusCode int, header http.Header, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Endpoint:   endpoint,
+		Body:       body,
+		Header:     header,
+		Messages:   parseErrorMessages(body),
+	}
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s: status %d %s", e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	if len(e.Messages) > 0 {
+		msg += ": " + strings.Join(e.Messages, "; ")
+	}
+	return msg
+}
+
+// Is lets errors.Is match an APIError against the sentinel errors of its
+// status class.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// errorBody covers the error shapes returned by the v1 and v2 APIs.
+type errorBody struct {
+	Errors  []json.RawMessage `json:"errors"`
+	Detail  string            `json:"detail"`
+	Error   string            `json:"error"`
+	Message string            `json:"message"`
+}
+
+func parseErrorMessages(body []byte) []string {
+	var eb errorBody
+	if json.Unmarshal(body, &eb) != nil {
+		return nil
+	}
+
+	var msgs []string
+	for _, raw := range eb.Errors {
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			msgs = append(msgs, s)
+			continue
+		}
+		var obj struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(raw, &obj) == nil && obj.Message != "" {
+			msgs = append(msgs, obj.Message)
+		}
+	}
+	for _, s := range []string{eb.Detail, eb.Error, eb.Message} {
+		if s != "" {
+			msgs = append(msgs, s)
+		}
+	}
+	return msgs
+}
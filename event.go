@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 	"time"
 )
@@ -28,8 +29,8 @@ type Event struct {
 	Seller              *Account            `json:"seller" bson:"seller"`
 	DevFeePaymentEvent  *DevFeePaymentEvent `json:"dev_fee_payment_event" bson:"dev_fee_payment_event"`
 	CollectionSlug      string              `json:"collection_slug" bson:"collection_slug"`
-	CreatedDate         TimeNano            `json:"created_date" bson:"created_date"`
-	ModifiedDate        TimeNano            `json:"modified_date" bson:"modified_date"`
+	CreatedDate         Timestamp           `json:"created_date" bson:"created_date"`
+	ModifiedDate        Timestamp           `json:"modified_date" bson:"modified_date"`
 	ContractAddress     Address             `json:"contract_address" bson:"contract_address"`
 	LogIndex            interface{}         `json:"log_index" bson:"log_index"`
 	EventType           EventType           `json:"event_type" bson:"event_type"`
@@ -44,7 +45,7 @@ type Event struct {
 	CustomEventName     interface{}         `json:"custom_event_name" bson:"custom_event_name"`
 	Quantity            string              `json:"quantity" bson:"quantity"`
 	PayoutAmount        interface{}         `json:"payout_amount" bson:"payout_amount"`
-	EventTimestamp      TimeNano            `json:"event_timestamp" bson:"event_timestamp"`
+	EventTimestamp      Timestamp           `json:"event_timestamp" bson:"event_timestamp"`
 	Relayer             string              `json:"relayer" bson:"relayer"`
 	Collection          uint64              `json:"collection" bson:"collection"`
 	PayoutAccount       interface{}         `json:"payout_account" bson:"payout_account"`
@@ -52,33 +53,99 @@ type Event struct {
 	PayoutCollection    interface{}         `json:"payout_collection" bson:"payout_collection"`
 	BuyOrder            uint64              `json:"buy_order" bson:"buy_order"`
 	SellOrder           uint64              `json:"sell_order" bson:"sell_order"`
+	Chain               Chain               `json:"chain,omitempty" bson:"chain,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 func (e Event) IsBundle() bool {
 	return e.AssetBundle != nil
 }
 
+// Price returns TotalPrice in whole units of the event's payment token.
+func (e Event) Price() (Decimal, error) {
+	if e.PaymentToken == nil {
+		return Decimal{}, fmt.Errorf("event %d has no payment token", e.ID)
+	}
+	return e.PaymentToken.Units(e.TotalPrice)
+}
+
+// setChain records chain on the event and the assets it refers to.
+func (e *Event) setChain(chain Chain) {
+	if e.Chain == "" {
+		e.Chain = chain
+	}
+	if e.Asset != nil {
+		e.Asset.setChain(chain)
+	}
+	if e.AssetBundle != nil {
+		for _, a := range e.AssetBundle.Assets {
+			if a != nil {
+				a.setChain(chain)
+			}
+		}
+	}
+}
+
 type PaymentToken struct {
-	Symbol   string      `json:"symbol" bson:"symbol"`
-	Address  Address     `json:"address" bson:"address"`
-	ImageURL string      `json:"image_url" bson:"image_url"`
-	Name     string      `json:"name" bson:"name"`
-	Decimals int64       `json:"decimals" bson:"decimals"`
-	EthPrice interface{} `json:"eth_price" bson:"eth_price"`
-	UsdPrice interface{} `json:"usd_price" bson:"usd_price"`
+	Symbol   string  `json:"symbol" bson:"symbol"`
+	Address  Address `json:"address" bson:"address"`
+	ImageURL string  `json:"image_url" bson:"image_url"`
+	Name     string  `json:"name" bson:"name"`
+	Decimals int64   `json:"decimals" bson:"decimals"`
+	EthPrice Decimal `json:"eth_price" bson:"eth_price"`
+	UsdPrice Decimal `json:"usd_price" bson:"usd_price"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// Units converts an amount in the token's smallest unit to whole tokens.
+func (t PaymentToken) Units(amount Number) (Decimal, error) {
+	wei, err := amount.Decimal()
+	if err != nil {
+		return Decimal{}, err
+	}
+	return FromWei(wei, t.Decimals)
+}
+
+// Wei converts an amount of whole tokens to the token's smallest unit.
+func (t PaymentToken) Wei(units Decimal) (*big.Int, error) {
+	return ToWei(units, t.Decimals)
+}
+
+// ETH converts an amount in the token's smallest unit to ether at the
+// token's quoted ETH price.
+func (t PaymentToken) ETH(amount Number) (Decimal, error) {
+	units, err := t.Units(amount)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return units.Mul(t.EthPrice)
+}
+
+// USD converts an amount in the token's smallest unit to dollars at the
+// token's quoted USD price.
+func (t PaymentToken) USD(amount Number) (Decimal, error) {
+	units, err := t.Units(amount)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return units.Mul(t.UsdPrice)
 }
 
 type Transaction struct {
-	ID               int64    `json:"id" bson:"id"`
-	FromAccount      Account  `json:"from_account" bson:"from_account"`
-	ToAccount        Account  `json:"to_account" bson:"to_account"`
-	CreatedDate      TimeNano `json:"created_date" bson:"created_date"`
-	ModifiedDate     TimeNano `json:"modified_date" bson:"modified_date"`
-	TransactionHash  string   `json:"transaction_hash" bson:"transaction_hash"`
-	TransactionIndex string   `json:"transaction_index" bson:"transaction_index"`
-	BlockNumber      string   `json:"block_number" bson:"block_number"`
-	BlockHash        string   `json:"block_hash" bson:"block_hash"`
-	Timestamp        string   `json:"timestamp" bson:"timestamp"`
+	ID               int64     `json:"id" bson:"id"`
+	FromAccount      Account   `json:"from_account" bson:"from_account"`
+	ToAccount        Account   `json:"to_account" bson:"to_account"`
+	CreatedDate      Timestamp `json:"created_date" bson:"created_date"`
+	ModifiedDate     Timestamp `json:"modified_date" bson:"modified_date"`
+	TransactionHash  string    `json:"transaction_hash" bson:"transaction_hash"`
+	TransactionIndex string    `json:"transaction_index" bson:"transaction_index"`
+	BlockNumber      string    `json:"block_number" bson:"block_number"`
+	BlockHash        string    `json:"block_hash" bson:"block_hash"`
+	Timestamp        Timestamp `json:"timestamp" bson:"timestamp"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 // AssetBundle is a simplified version of an asset or an asset bundle.
@@ -92,16 +159,20 @@ type AssetBundle struct {
 	AssetContract *AssetContract `json:"asset_contract" bson:"asset_contract"`
 	Permalink     string         `json:"permalink" bson:"permalink"`
 	SellOrders    interface{}    `json:"sell_orders" bson:"sell_orders"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 // DevFeePaymentEvent is fee transfer event from OpenSea to Dev, It appears to be running in bulk on a regular basis.
 type DevFeePaymentEvent struct {
 	EventType      string       `json:"event_type" bson:"event_type"`
-	EventTimestamp string       `json:"event_timestamp" bson:"event_timestamp"`
+	EventTimestamp Timestamp    `json:"event_timestamp" bson:"event_timestamp"`
 	AuctionType    interface{}  `json:"auction_type" bson:"auction_type"`
 	TotalPrice     interface{}  `json:"total_price" bson:"total_price"`
 	Transaction    Transaction  `json:"transaction" bson:"transaction"`
 	PaymentToken   PaymentToken `json:"payment_token" bson:"payment_token"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 type EventType string
@@ -129,7 +200,7 @@ const (
 
 type RetrievingEventsParams struct {
 	AssetContractAddress Address
-	TokenID              int32
+	TokenID              TokenID
 	AccountAddress       Address
 	EventType            EventType
 	OnlyOpensea          bool
@@ -143,7 +214,6 @@ type RetrievingEventsParams struct {
 func NewRetrievingEventsParams() *RetrievingEventsParams {
 	return &RetrievingEventsParams{
 		AssetContractAddress: NullAddress,
-		TokenID:              0,
 		AccountAddress:       NullAddress,
 		EventType:            EventTypeNone,
 		OnlyOpensea:          true,
@@ -171,8 +241,8 @@ func (p RetrievingEventsParams) Encode() string {
 	if p.AssetContractAddress != NullAddress {
 		q.Set("asset_contract_address", p.AssetContractAddress.String())
 	}
-	if p.TokenID != 0 {
-		q.Set("token_id", fmt.Sprintf("%d", p.TokenID))
+	if p.TokenID.IsSet() {
+		q.Set("token_id", p.TokenID.String())
 	}
 	if p.AccountAddress != NullAddress {
 		q.Set("account_address", p.AccountAddress.String())
@@ -196,66 +266,74 @@ func (p RetrievingEventsParams) Encode() string {
 	return q.Encode()
 }
 
-func (o Opensea) RetrievingEvents(params *RetrievingEventsParams) ([]*Event, error) {
+func (c *Client) RetrievingEvents(params *RetrievingEventsParams) ([]*Event, error) {
 	ctx := context.TODO()
-	return o.RetrievingEventsWithContext(ctx, params)
+	return c.RetrievingEventsWithContext(ctx, params)
 }
 
-func (o Opensea) RetrievingEventsWithContext(ctx context.Context, params *RetrievingEventsParams) (events []*Event, err error) {
+func (c *Client) RetrievingEventsWithContext(ctx context.Context, params *RetrievingEventsParams) ([]*Event, error) {
+	return c.EventsPager(params).Collect(ctx)
+}
+
+// EventsPager returns a Pager over the events matching params, starting at
+// params.Offset. params is copied and left untouched.
+func (c *Client) EventsPager(params *RetrievingEventsParams) *Pager[*Event] {
 	if params == nil {
 		params = NewRetrievingEventsParams()
 	}
+	p := *params
 
-	events = []*Event{}
-	for {
-		path := "/api/v1/events/?" + params.Encode()
-		b, err := o.GetPath(ctx, path)
-		if err != nil {
-			return nil, err
-		}
+	return NewOffsetPager(p.Offset, p.Limit, func(ctx context.Context, offset, limit int) ([]*Event, error) {
+		page := p
+		page.Offset, page.Limit = offset, limit
+		return c.retrieveEventsPage(ctx, &page)
+	}).filter(p.matches)
+}
 
-		eventsResp := &AssetEventsResponse{
-			AssetEvents: []Event{},
-		}
-		err = json.Unmarshal(b, eventsResp)
-		if err != nil {
-			return nil, err
-		}
+// retrieveEventsPage fetches a single page of events.
+func (c *Client) retrieveEventsPage(ctx context.Context, params *RetrievingEventsParams) ([]*Event, error) {
+	path := eventsEP + "?" + params.Encode()
+	b, err := c.get(ctx, "RetrievingEvents", path)
+	if err != nil {
+		return nil, err
+	}
 
-		// Filters
-		tmp := make([]*Event, len(eventsResp.AssetEvents))
-		cnt := 0
-		for i, e := range eventsResp.AssetEvents {
-			// remove incorrect asset, the events are bundled collection
-			if params.AssetContractAddress != NullAddress {
-				if e.Asset != nil && e.Asset.AssetContract.Address != params.AssetContractAddress {
-					continue
-				}
-
-				if e.AssetBundle != nil {
-					ok := false
-					for _, a := range e.AssetBundle.Assets {
-						if a.AssetContract.Address == params.AssetContractAddress {
-							ok = true
-							break
-						}
-					}
-					if !ok {
-						continue
-					}
-				}
-			}
+	eventsResp := &AssetEventsResponse{
+		AssetEvents: []Event{},
+	}
+	err = json.Unmarshal(b, eventsResp)
+	if err != nil {
+		return nil, err
+	}
 
-			tmp[cnt] = &eventsResp.AssetEvents[i]
-			cnt++
-		}
-		events = append(events, tmp[0:cnt]...)
+	events := make([]*Event, len(eventsResp.AssetEvents))
+	for i := range eventsResp.AssetEvents {
+		e := &eventsResp.AssetEvents[i]
+		e.setChain(c.chain)
+		events[i] = e
+	}
+	return events, nil
+}
 
-		if len(eventsResp.AssetEvents) < params.Limit {
-			break
+// matches removes incorrect assets: when filtering by contract, the API
+// also returns events of bundles and collections sharing the contract.
+func (p RetrievingEventsParams) matches(e *Event) bool {
+	if p.AssetContractAddress == NullAddress {
+		return true
+	}
+
+	if e.Asset != nil && e.Asset.AssetContract != nil && !e.Asset.AssetContract.Address.Equal(p.AssetContractAddress) {
+		return false
+	}
+
+	if e.AssetBundle != nil {
+		for _, a := range e.AssetBundle.Assets {
+			if a.AssetContract != nil && a.AssetContract.Address.Equal(p.AssetContractAddress) {
+				return true
+			}
 		}
-		params.Offset += params.Limit
+		return false
 	}
 
-	return
+	return true
 }
@@ -0,0 +1,140 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// v2 event types
+const (
+	EventTypeSale            EventType = "sale"
+	EventTypeMint            EventType = "mint"
+	EventTypeListing         EventType = "listing"
+	EventTypeOffer           EventType = "offer"
+	EventTypeTraitOffer      EventType = "trait_offer"
+	EventTypeCollectionOffer EventType = "collection_offer"
+	EventTypeCancel          EventType = "cancel"
+	EventTypeRedemption      EventType = "redemption"
+)
+
+// AssetEvent is an event as returned by the v2 events endpoints. Which
+// fields are set depends on EventType.
+type AssetEvent struct {
+	EventType        EventType     `json:"event_type" bson:"event_type"`
+	OrderType        string        `json:"order_type" bson:"order_type"`
+	EventTimestamp   Timestamp     `json:"event_timestamp" bson:"event_timestamp"`
+	Transaction      string        `json:"transaction" bson:"transaction"`
+	OrderHash        string        `json:"order_hash" bson:"order_hash"`
+	ProtocolAddress  Address       `json:"protocol_address" bson:"protocol_address"`
+	Chain            Chain         `json:"chain" bson:"chain"`
+	Payment          *EventPayment `json:"payment" bson:"payment"`
+	ClosingDate      Timestamp     `json:"closing_date" bson:"closing_date"`
+	StartDate        Timestamp     `json:"start_date" bson:"start_date"`
+	ExpirationDate   Timestamp     `json:"expiration_date" bson:"expiration_date"`
+	Seller           Address       `json:"seller" bson:"seller"`
+	Buyer            Address       `json:"buyer" bson:"buyer"`
+	Maker            Address       `json:"maker" bson:"maker"`
+	Taker            Address       `json:"taker" bson:"taker"`
+	FromAddress      Address       `json:"from_address" bson:"from_address"`
+	ToAddress        Address       `json:"to_address" bson:"to_address"`
+	Quantity         int64         `json:"quantity" bson:"quantity"`
+	NFT              *NFT          `json:"nft" bson:"nft"`
+	Asset            *NFT          `json:"asset" bson:"asset"`
+	Criteria         any           `json:"criteria" bson:"criteria"`
+	IsPrivateListing bool          `json:"is_private_listing" bson:"is_private_listing"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// EventPayment is the amount paid in a sale, listing or offer event.
+type EventPayment struct {
+	Quantity     Number  `json:"quantity" bson:"quantity"`
+	TokenAddress Address `json:"token_address" bson:"token_address"`
+	Decimals     int64   `json:"decimals" bson:"decimals"`
+	Symbol       string  `json:"symbol" bson:"symbol"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// AssetEventsPage is a page of events returned by the v2 events endpoints.
+type AssetEventsPage struct {
+	AssetEvents []AssetEvent `json:"asset_events" bson:"asset_events"`
+	Next        string       `json:"next" bson:"next"`
+}
+
+// EventsParams filters and pages the v2 events endpoints.
+type EventsParams struct {
+	After      int64       // only events after this Unix time
+	Before     int64       // only events before this Unix time
+	EventTypes []EventType // only these event types
+	Limit      int
+	Next       string
+}
+
+func (p EventsParams) values() url.Values {
+	q := ListParams{Limit: p.Limit, Next: p.Next}.values()
+	if p.After > 0 {
+		q.Set("after", strconv.FormatInt(p.After, 10))
+	}
+	if p.Before > 0 {
+		q.Set("before", strconv.FormatInt(p.Before, 10))
+	}
+	for _, t := range p.EventTypes {
+		q.Add("event_type", string(t))
+	}
+	return q
+}
+
+// ListEventsByCollection retrieves a page of the events of a collection.
+func (c *Client) ListEventsByCollection(ctx context.Context, slug string, params EventsParams) (*AssetEventsPage, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+	return c.listEvents(ctx, "ListEventsByCollection", fmt.Sprintf(collectionEventsV2EP, url.PathEscape(slug)), params)
+}
+
+// ListEventsByAccount retrieves a page of the events involving an account.
+func (c *Client) ListEventsByAccount(ctx context.Context, address string, params EventsParams) (*AssetEventsPage, error) {
+	if address == "" {
+		return nil, fmt.Errorf("account address cannot be empty")
+	}
+	return c.listEvents(ctx, "ListEventsByAccount", fmt.Sprintf(accountEventsV2EP, address), params)
+}
+
+// ListEventsByNFT retrieves a page of the events of a single NFT.
+func (c *Client) ListEventsByNFT(ctx context.Context, chain Chain, address string, identifier TokenID, params EventsParams) (*AssetEventsPage, error) {
+	chain, err := c.resolveChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, ErrEmptyContractAddress
+	}
+	if !identifier.IsSet() {
+		return nil, fmt.Errorf("token ID cannot be empty")
+	}
+	return c.listEvents(ctx, "ListEventsByNFT", fmt.Sprintf(nftEventsV2EP, chain, address, identifier), params)
+}
+
+func (c *Client) listEvents(ctx context.Context, op, path string, params EventsParams) (*AssetEventsPage, error) {
+	if q := params.values().Encode(); q != "" {
+		path += "?" + q
+	}
+
+	var page AssetEventsPage
+	if err := c.getJSON(ctx, op, path, &page); err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	for i := range page.AssetEvents {
+		e := &page.AssetEvents[i]
+		for _, nft := range []*NFT{e.NFT, e.Asset} {
+			if nft != nil && nft.Chain == "" {
+				nft.Chain = e.Chain
+			}
+		}
+	}
+	return &page, nil
+}
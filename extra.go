@@ -0,0 +1,247 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// The models keep the JSON object members they do not declare in an Extra
+// field, so documents survive a decode and encode unchanged even after
+// OpenSea adds fields. Each model implements UnmarshalJSON and MarshalJSON
+// with unmarshalExtra and marshalExtra on a method-less copy of its type.
+
+// jsonFieldInfo describes a struct field as encoding/json sees it.
+type jsonFieldInfo struct {
+	name      string
+	typ       reflect.Type
+	omitEmpty bool
+}
+
+// jsonFieldCache maps a struct type to its fields keyed by lowercased JSON
+// name, matching the case-insensitive key matching of encoding/json.
+var jsonFieldCache sync.Map // reflect.Type -> map[string]jsonFieldInfo
+
+func jsonFields(t reflect.Type) map[string]jsonFieldInfo {
+	if f, ok := jsonFieldCache.Load(t); ok {
+		return f.(map[string]jsonFieldInfo)
+	}
+	fields := map[string]jsonFieldInfo{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			for k, v := range jsonFields(sf.Type) {
+				fields[k] = v
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields[strings.ToLower(name)] = jsonFieldInfo{
+			name:      name,
+			typ:       sf.Type,
+			omitEmpty: slices.Contains(strings.Split(opts, ","), "omitempty"),
+		}
+	}
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+// unmarshalExtra decodes b into v, a pointer to a struct, and stores the
+// members of b that v does not declare in extra.
+func unmarshalExtra(b []byte, v any, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil || raw == nil {
+		return err
+	}
+
+	known := jsonFields(reflect.TypeOf(v).Elem())
+	*extra = nil
+	for k, m := range raw {
+		if _, ok := known[strings.ToLower(k)]; ok {
+			continue
+		}
+		if *extra == nil {
+			*extra = map[string]json.RawMessage{}
+		}
+		(*extra)[k] = m
+	}
+	return nil
+}
+
+// marshalExtra encodes v, a struct, followed by the members of extra that v
+// does not declare, in key order.
+func marshalExtra(v any, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	known := jsonFields(reflect.TypeOf(v))
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if _, ok := known[strings.ToLower(k)]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var buf bytes.Buffer
+	buf.Write(b[:len(b)-1])
+	comma := len(b) > 2
+	for _, k := range keys {
+		val, err := json.Marshal(extra[k])
+		if err != nil {
+			return nil, fmt.Errorf("extra field %q: %w", k, err)
+		}
+		if comma {
+			buf.WriteByte(',')
+		}
+		comma = true
+		key, _ := json.Marshal(k)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// SchemaDrift is the error returned by UnmarshalStrict. Paths are dotted
+// JSON keys, with [] standing for any array element and {} for any map
+// value.
+type SchemaDrift struct {
+	// Unknown lists the members of the document the model does not declare.
+	Unknown []string
+	// Missing lists the members the model declares, without omitempty, that
+	// the document lacks.
+	Missing []string
+}
+
+func (d *SchemaDrift) Error() string {
+	var parts []string
+	if len(d.Unknown) > 0 {
+		parts = append(parts, "unknown fields: "+strings.Join(d.Unknown, ", "))
+	}
+	if len(d.Missing) > 0 {
+		parts = append(parts, "missing fields: "+strings.Join(d.Missing, ", "))
+	}
+	return "schema drift: " + strings.Join(parts, "; ")
+}
+
+// UnmarshalStrict decodes data into v like json.Unmarshal and then compares
+// the document with the declared fields of v, recursively. If they differ
+// it returns a *SchemaDrift, with v still fully decoded. It is meant for
+// tests that watch for changes in the API schema.
+func UnmarshalStrict(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	unknown, missing := map[string]bool{}, map[string]bool{}
+	walkDrift(reflect.TypeOf(v), data, "", unknown, missing)
+	if len(unknown) == 0 && len(missing) == 0 {
+		return nil
+	}
+	d := &SchemaDrift{}
+	for p := range unknown {
+		d.Unknown = append(d.Unknown, p)
+	}
+	for p := range missing {
+		d.Missing = append(d.Missing, p)
+	}
+	slices.Sort(d.Unknown)
+	slices.Sort(d.Missing)
+	return d
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	extraFieldType      = reflect.TypeFor[map[string]json.RawMessage]()
+)
+
+// isModel reports whether t is a struct that keeps unknown members in an
+// Extra field.
+func isModel(t reflect.Type) bool {
+	f, ok := t.FieldByName("Extra")
+	return ok && f.Type == extraFieldType
+}
+
+func walkDrift(t reflect.Type, raw json.RawMessage, path string, unknown, missing map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if string(bytes.TrimSpace(raw)) == "null" {
+		return
+	}
+	// Types with their own decoding, such as Decimal or Traits, are leaves.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) && !(t.Kind() == reflect.Struct && isModel(t)) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if json.Unmarshal(raw, &obj) != nil {
+			return
+		}
+		present := make(map[string]json.RawMessage, len(obj))
+		for k, m := range obj {
+			present[strings.ToLower(k)] = m
+			if _, ok := jsonFields(t)[strings.ToLower(k)]; !ok {
+				unknown[joinPath(path, k)] = true
+			}
+		}
+		for key, f := range jsonFields(t) {
+			m, ok := present[key]
+			if !ok {
+				if !f.omitEmpty {
+					missing[joinPath(path, f.name)] = true
+				}
+				continue
+			}
+			walkDrift(f.typ, m, joinPath(path, f.name), unknown, missing)
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		var elems []json.RawMessage
+		if json.Unmarshal(raw, &elems) != nil {
+			return
+		}
+		for _, m := range elems {
+			walkDrift(t.Elem(), m, path+"[]", unknown, missing)
+		}
+	case reflect.Map:
+		var vals map[string]json.RawMessage
+		if json.Unmarshal(raw, &vals) != nil {
+			return
+		}
+		for _, m := range vals {
+			walkDrift(t.Elem(), m, path+"{}", unknown, missing)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,374 @@
+package opensea
+
+import "maps"
+
+func (c *Collection) UnmarshalJSON(b []byte) error {
+	type plain Collection
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c Collection) MarshalJSON() ([]byte, error) {
+	type plain Collection
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (u *User) UnmarshalJSON(b []byte) error {
+	type plain User
+	return unmarshalExtra(b, (*plain)(u), &u.Extra)
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type plain User
+	return marshalExtra(plain(u), u.Extra)
+}
+
+func (a *Account) UnmarshalJSON(b []byte) error {
+	type plain Account
+	return unmarshalExtra(b, (*plain)(a), &a.Extra)
+}
+
+func (a Account) MarshalJSON() ([]byte, error) {
+	type plain Account
+	return marshalExtra(plain(a), a.Extra)
+}
+
+func (n *NFTContract) UnmarshalJSON(b []byte) error {
+	type plain NFTContract
+	return unmarshalExtra(b, (*plain)(n), &n.Extra)
+}
+
+func (n NFTContract) MarshalJSON() ([]byte, error) {
+	type plain NFTContract
+	return marshalExtra(plain(n), n.Extra)
+}
+
+func (a *Asset) UnmarshalJSON(b []byte) error {
+	type plain Asset
+	return unmarshalExtra(b, (*plain)(a), &a.Extra)
+}
+
+func (a Asset) MarshalJSON() ([]byte, error) {
+	type plain Asset
+	return marshalExtra(plain(a), a.Extra)
+}
+
+func (a *AssetContract) UnmarshalJSON(b []byte) error {
+	type plain AssetContract
+	return unmarshalExtra(b, (*plain)(a), &a.Extra)
+}
+
+func (a AssetContract) MarshalJSON() ([]byte, error) {
+	type plain AssetContract
+	return marshalExtra(plain(a), a.Extra)
+}
+
+func (e *Event) UnmarshalJSON(b []byte) error {
+	type plain Event
+	return unmarshalExtra(b, (*plain)(e), &e.Extra)
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	type plain Event
+	return marshalExtra(plain(e), e.Extra)
+}
+
+func (p *PaymentToken) UnmarshalJSON(b []byte) error {
+	type plain PaymentToken
+	return unmarshalExtra(b, (*plain)(p), &p.Extra)
+}
+
+func (p PaymentToken) MarshalJSON() ([]byte, error) {
+	type plain PaymentToken
+	return marshalExtra(plain(p), p.Extra)
+}
+
+func (t *Transaction) UnmarshalJSON(b []byte) error {
+	type plain Transaction
+	return unmarshalExtra(b, (*plain)(t), &t.Extra)
+}
+
+func (t Transaction) MarshalJSON() ([]byte, error) {
+	type plain Transaction
+	return marshalExtra(plain(t), t.Extra)
+}
+
+func (a *AssetBundle) UnmarshalJSON(b []byte) error {
+	type plain AssetBundle
+	return unmarshalExtra(b, (*plain)(a), &a.Extra)
+}
+
+func (a AssetBundle) MarshalJSON() ([]byte, error) {
+	type plain AssetBundle
+	return marshalExtra(plain(a), a.Extra)
+}
+
+func (d *DevFeePaymentEvent) UnmarshalJSON(b []byte) error {
+	type plain DevFeePaymentEvent
+	return unmarshalExtra(b, (*plain)(d), &d.Extra)
+}
+
+func (d DevFeePaymentEvent) MarshalJSON() ([]byte, error) {
+	type plain DevFeePaymentEvent
+	return marshalExtra(plain(d), d.Extra)
+}
+
+func (o *Order) UnmarshalJSON(b []byte) error {
+	type plain Order
+	return unmarshalExtra(b, (*plain)(o), &o.Extra)
+}
+
+func (o Order) MarshalJSON() ([]byte, error) {
+	type plain Order
+	return marshalExtra(plain(o), o.Extra)
+}
+
+func (c *CollectionDetails) UnmarshalJSON(b []byte) error {
+	type plain CollectionDetails
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionDetails) MarshalJSON() ([]byte, error) {
+	type plain CollectionDetails
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionContract) UnmarshalJSON(b []byte) error {
+	type plain CollectionContract
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionContract) MarshalJSON() ([]byte, error) {
+	type plain CollectionContract
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionFee) UnmarshalJSON(b []byte) error {
+	type plain CollectionFee
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionFee) MarshalJSON() ([]byte, error) {
+	type plain CollectionFee
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionToken) UnmarshalJSON(b []byte) error {
+	type plain CollectionToken
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionToken) MarshalJSON() ([]byte, error) {
+	type plain CollectionToken
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionStats) UnmarshalJSON(b []byte) error {
+	type plain CollectionStats
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionStats) MarshalJSON() ([]byte, error) {
+	type plain CollectionStats
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionTotalStats) UnmarshalJSON(b []byte) error {
+	type plain CollectionTotalStats
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionTotalStats) MarshalJSON() ([]byte, error) {
+	type plain CollectionTotalStats
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (c *CollectionIntervalStats) UnmarshalJSON(b []byte) error {
+	type plain CollectionIntervalStats
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c CollectionIntervalStats) MarshalJSON() ([]byte, error) {
+	type plain CollectionIntervalStats
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (a *AssetEvent) UnmarshalJSON(b []byte) error {
+	type plain AssetEvent
+	return unmarshalExtra(b, (*plain)(a), &a.Extra)
+}
+
+func (a AssetEvent) MarshalJSON() ([]byte, error) {
+	type plain AssetEvent
+	return marshalExtra(plain(a), a.Extra)
+}
+
+func (e *EventPayment) UnmarshalJSON(b []byte) error {
+	type plain EventPayment
+	return unmarshalExtra(b, (*plain)(e), &e.Extra)
+}
+
+func (e EventPayment) MarshalJSON() ([]byte, error) {
+	type plain EventPayment
+	return marshalExtra(plain(e), e.Extra)
+}
+
+func (n *NFT) UnmarshalJSON(b []byte) error {
+	type plain NFT
+	return unmarshalExtra(b, (*plain)(n), &n.Extra)
+}
+
+func (n NFT) MarshalJSON() ([]byte, error) {
+	type plain NFT
+	return marshalExtra(plain(n), n.Extra)
+}
+
+func (n *NFTOwner) UnmarshalJSON(b []byte) error {
+	type plain NFTOwner
+	return unmarshalExtra(b, (*plain)(n), &n.Extra)
+}
+
+func (n NFTOwner) MarshalJSON() ([]byte, error) {
+	type plain NFTOwner
+	return marshalExtra(plain(n), n.Extra)
+}
+
+func (n *NFTRarity) UnmarshalJSON(b []byte) error {
+	type plain NFTRarity
+	return unmarshalExtra(b, (*plain)(n), &n.Extra)
+}
+
+func (n NFTRarity) MarshalJSON() ([]byte, error) {
+	type plain NFTRarity
+	return marshalExtra(plain(n), n.Extra)
+}
+
+func (c *Contract) UnmarshalJSON(b []byte) error {
+	type plain Contract
+	return unmarshalExtra(b, (*plain)(c), &c.Extra)
+}
+
+func (c Contract) MarshalJSON() ([]byte, error) {
+	type plain Contract
+	return marshalExtra(plain(c), c.Extra)
+}
+
+func (l *Listing) UnmarshalJSON(b []byte) error {
+	type plain Listing
+	return unmarshalExtra(b, (*plain)(l), &l.Extra)
+}
+
+func (l Listing) MarshalJSON() ([]byte, error) {
+	type plain Listing
+	return marshalExtra(plain(l), l.Extra)
+}
+
+func (l *ListingPrice) UnmarshalJSON(b []byte) error {
+	type plain ListingPrice
+	return unmarshalExtra(b, (*plain)(l), &l.Extra)
+}
+
+func (l ListingPrice) MarshalJSON() ([]byte, error) {
+	type plain ListingPrice
+	return marshalExtra(plain(l), l.Extra)
+}
+
+func (p *Price) UnmarshalJSON(b []byte) error {
+	type plain Price
+	return unmarshalExtra(b, (*plain)(p), &p.Extra)
+}
+
+func (p Price) MarshalJSON() ([]byte, error) {
+	type plain Price
+	return marshalExtra(plain(p), p.Extra)
+}
+
+func (o *Offer) UnmarshalJSON(b []byte) error {
+	type plain Offer
+	return unmarshalExtra(b, (*plain)(o), &o.Extra)
+}
+
+func (o Offer) MarshalJSON() ([]byte, error) {
+	type plain Offer
+	return marshalExtra(plain(o), o.Extra)
+}
+
+func (o *OfferCriteria) UnmarshalJSON(b []byte) error {
+	type plain OfferCriteria
+	return unmarshalExtra(b, (*plain)(o), &o.Extra)
+}
+
+func (o OfferCriteria) MarshalJSON() ([]byte, error) {
+	type plain OfferCriteria
+	return marshalExtra(plain(o), o.Extra)
+}
+
+func (p *ProtocolData) UnmarshalJSON(b []byte) error {
+	type plain ProtocolData
+	return unmarshalExtra(b, (*plain)(p), &p.Extra)
+}
+
+func (p ProtocolData) MarshalJSON() ([]byte, error) {
+	type plain ProtocolData
+	return marshalExtra(plain(p), p.Extra)
+}
+
+func (o *OrderParameters) UnmarshalJSON(b []byte) error {
+	type plain OrderParameters
+	return unmarshalExtra(b, (*plain)(o), &o.Extra)
+}
+
+func (o OrderParameters) MarshalJSON() ([]byte, error) {
+	type plain OrderParameters
+	return marshalExtra(plain(o), o.Extra)
+}
+
+func (o *OfferItem) UnmarshalJSON(b []byte) error {
+	type plain OfferItem
+	return unmarshalExtra(b, (*plain)(o), &o.Extra)
+}
+
+func (o OfferItem) MarshalJSON() ([]byte, error) {
+	type plain OfferItem
+	return marshalExtra(plain(o), o.Extra)
+}
+
+// offerItemPlain is OfferItem without its methods. ConsiderationItem embeds
+// it in its codec so that OfferItem's promoted methods do not take over the
+// whole item and drop Recipient.
+type offerItemPlain OfferItem
+
+type considerationItemPlain struct {
+	offerItemPlain
+	Recipient Address `json:"recipient"`
+}
+
+func (c *ConsiderationItem) UnmarshalJSON(b []byte) error {
+	var p considerationItemPlain
+	if err := unmarshalExtra(b, &p, &c.Extra); err != nil {
+		return err
+	}
+	c.OfferItem = OfferItem(p.offerItemPlain)
+	c.Recipient = p.Recipient
+	return nil
+}
+
+func (c ConsiderationItem) MarshalJSON() ([]byte, error) {
+	p := considerationItemPlain{offerItemPlain: offerItemPlain(c.OfferItem), Recipient: c.Recipient}
+	// Members set on the embedded OfferItem are kept too; c.Extra wins.
+	extra := c.Extra
+	if len(c.OfferItem.Extra) > 0 {
+		extra = maps.Clone(c.OfferItem.Extra)
+		maps.Copy(extra, c.Extra)
+	}
+	return marshalExtra(p, extra)
+}
+
+func (t *Trait) UnmarshalJSON(b []byte) error {
+	type plain Trait
+	return unmarshalExtra(b, (*plain)(t), &t.Extra)
+}
+
+func (t Trait) MarshalJSON() ([]byte, error) {
+	type plain Trait
+	return marshalExtra(plain(t), t.Extra)
+}
@@ -0,0 +1,190 @@
+package opensea
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// basisPointsPerUnit is the number of basis points in a fraction of 1.
+const basisPointsPerUnit = 10000
+
+// OpenSeaFeeRecipient is the address OpenSea collects its own fee at. The v2
+// API lists it among a collection's fees like any creator fee.
+const OpenSeaFeeRecipient Address = "0x0000a26b00c1f0df003000390027140000faa719"
+
+// BasisPoints is a fee rate in hundredths of a percent: 250 is 2.5%. It
+// decodes from a JSON string or number.
+type BasisPoints int64
+
+// Percent returns b as a percentage, e.g. 2.5 for 250.
+func (b BasisPoints) Percent() Decimal {
+	return NewDecimal(big.NewInt(int64(b)), 2)
+}
+
+// Fraction returns b as a fraction of 1, e.g. 0.025 for 250.
+func (b BasisPoints) Fraction() Decimal {
+	return NewDecimal(big.NewInt(int64(b)), 4)
+}
+
+// Of returns the fee at rate b on amount.
+func (b BasisPoints) Of(amount Decimal) (Decimal, error) {
+	return amount.Mul(b.Fraction())
+}
+
+// Apply returns the fee at rate b on price. The fee is in the same unit as
+// the price, typically wei.
+func (b BasisPoints) Apply(price Number) (Decimal, error) {
+	p, err := price.Decimal()
+	if err != nil {
+		return Decimal{}, err
+	}
+	return b.Of(p)
+}
+
+// String returns b as a percentage, e.g. "2.5%".
+func (b BasisPoints) String() string {
+	return b.Percent().String() + "%"
+}
+
+// UnmarshalJSON decodes a JSON number or a string holding one. null and ""
+// decode to 0.
+func (b *BasisPoints) UnmarshalJSON(data []byte) error {
+	s, err := unquoteNumber(data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal basis points: %w", err)
+	}
+	return b.set(s)
+}
+
+// MarshalBSONValue encodes b as a BSON int64.
+func (b BasisPoints) MarshalBSONValue() (byte, []byte, error) {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(b))
+	return bsonInt64, buf, nil
+}
+
+// UnmarshalBSONValue decodes a BSON number, a string holding one, or null.
+func (b *BasisPoints) UnmarshalBSONValue(typ byte, data []byte) error {
+	s, ok, err := unmarshalBSONNumber(typ, data)
+	if !ok {
+		s, err = unmarshalBSONString(typ, data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal basis points: %w", err)
+	}
+	return b.set(s)
+}
+
+func (b *BasisPoints) set(s string) error {
+	if s == "" {
+		*b = 0
+		return nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*b = BasisPoints(n)
+		return nil
+	}
+	// Accept integral values written with a fraction or exponent, as in
+	// "250.0" or 2.5e2.
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal basis points: %w", err)
+	}
+	n, exact := d.BigInt()
+	if !exact || !n.IsInt64() {
+		return fmt.Errorf("failed to unmarshal basis points: %q is not a whole number", s)
+	}
+	*b = BasisPoints(n.Int64())
+	return nil
+}
+
+// FeeSchedule summarizes the seller fees taken from a sale.
+type FeeSchedule struct {
+	Creator BasisPoints
+	OpenSea BasisPoints
+	Total   BasisPoints
+}
+
+func newFeeSchedule(creator, opensea BasisPoints) FeeSchedule {
+	return FeeSchedule{Creator: creator, OpenSea: opensea, Total: creator + opensea}
+}
+
+// Proceeds returns what the seller receives from a sale at price after all
+// fees.
+func (f FeeSchedule) Proceeds(price Number) (Decimal, error) {
+	p, err := price.Decimal()
+	if err != nil {
+		return Decimal{}, err
+	}
+	fee, err := f.Total.Of(p)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return p.Sub(fee), nil
+}
+
+// FeeSchedule returns the seller fees of the collection.
+func (c Collection) FeeSchedule() FeeSchedule {
+	return newFeeSchedule(c.DevSellerFeeBasisPoints, c.OpenseaSellerFeeBasisPoints)
+}
+
+// FeeSchedule returns the seller fees of the contract.
+func (c AssetContract) FeeSchedule() FeeSchedule {
+	return newFeeSchedule(c.DevSellerFeeBasisPoints, c.OpenseaSellerFeeBasisPoints)
+}
+
+// FeeSchedule returns the seller fees of the contract.
+func (c NFTContract) FeeSchedule() FeeSchedule {
+	return newFeeSchedule(c.DevSellerFeeBasisPoints, c.OpenseaSellerFeeBasisPoints)
+}
+
+// FeeSchedule returns the seller fees of the asset. Fees are set per
+// collection, so the collection's fees are used when the asset has a
+// collection, and its contract's otherwise.
+func (a Asset) FeeSchedule() FeeSchedule {
+	switch {
+	case a.Collection != nil:
+		return a.Collection.FeeSchedule()
+	case a.AssetContract != nil:
+		return a.AssetContract.FeeSchedule()
+	}
+	return FeeSchedule{}
+}
+
+// BasisPoints returns the fee rate, which the v2 API gives as a percent,
+// in basis points.
+func (f CollectionFee) BasisPoints() BasisPoints {
+	return BasisPoints(math.Round(f.Fee * 100))
+}
+
+// FeeSchedule returns the seller fees of the collection. Fees paid to
+// OpenSeaFeeRecipient are OpenSea's; all others, required or not, are
+// creator fees.
+func (c CollectionDetails) FeeSchedule() FeeSchedule {
+	var creator, opensea BasisPoints
+	for _, f := range c.Fees {
+		if f.Recipient.Equal(OpenSeaFeeRecipient) {
+			opensea += f.BasisPoints()
+		} else {
+			creator += f.BasisPoints()
+		}
+	}
+	return newFeeSchedule(creator, opensea)
+}
+
+// GetNFTFeeSchedule returns the seller fees of a v2 NFT. Fees are set per
+// collection, so it fetches the NFT's collection.
+func (c *Client) GetNFTFeeSchedule(ctx context.Context, nft *NFT) (FeeSchedule, error) {
+	if nft.Collection == "" {
+		return FeeSchedule{}, fmt.Errorf("NFT has no collection")
+	}
+	collection, err := c.GetCollection(ctx, nft.Collection)
+	if err != nil {
+		return FeeSchedule{}, err
+	}
+	return collection.FeeSchedule(), nil
+}
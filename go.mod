@@ -1,3 +1,5 @@
 module github.com/naevern/gopenseapi
 
 go 1.23.2
+
+require github.com/cheekybits/is v0.0.0-20150225183255-68e9c0620927
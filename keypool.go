@@ -0,0 +1,172 @@
+package opensea
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeyStrategy selects the next key of a KeyPool.
+type KeyStrategy int
+
+const (
+	// RoundRobin hands out the keys in turn.
+	RoundRobin KeyStrategy = iota
+	// LeastUsed hands out the key that has served the fewest requests.
+	LeastUsed
+)
+
+// DefaultKeyCooldown is how long a key sits out after a 401 or 429 when the
+// pool was created without a cool-down.
+const DefaultKeyCooldown = time.Minute
+
+// KeyStats is the usage of a single key of a KeyPool.
+type KeyStats struct {
+	Key           string
+	Requests      uint64
+	Unauthorized  uint64 // 401 and 403 responses
+	RateLimited   uint64 // 429 responses
+	LastUsed      time.Time
+	CooldownUntil time.Time
+}
+
+// KeyPool spreads requests over several API keys. A key answered with 401,
+// 403 or 429 sits out for the pool's cool-down, or for the Retry-After of a
+// 429 if that is longer. When every key is cooling down the one available
+// soonest is used. A KeyPool is safe for concurrent use and may be shared by
+// several clients.
+type KeyPool struct {
+	mu       sync.Mutex
+	strategy KeyStrategy
+	cooldown time.Duration
+	keys     []*KeyStats
+	byKey    map[string]*KeyStats
+	next     int
+}
+
+// NewKeyPool returns a pool over keys. Empty and duplicate keys are ignored,
+// and a cooldown of zero selects DefaultKeyCooldown.
+func NewKeyPool(keys []string, strategy KeyStrategy, cooldown time.Duration) *KeyPool {
+	if cooldown <= 0 {
+		cooldown = DefaultKeyCooldown
+	}
+	p := &KeyPool{
+		strategy: strategy,
+		cooldown: cooldown,
+		byKey:    make(map[string]*KeyStats, len(keys)),
+	}
+	for _, k := range keys {
+		if _, ok := p.byKey[k]; ok || k == "" {
+			continue
+		}
+		s := &KeyStats{Key: k}
+		p.keys = append(p.keys, s)
+		p.byKey[k] = s
+	}
+	return p
+}
+
+// WithKeyPool makes the client take the key of every request, retries
+// included, from pool instead of using a single API key.
+func WithKeyPool(pool *KeyPool) Option {
+	return func(c *Client) {
+		c.keys = pool
+	}
+}
+
+// Stats returns a snapshot of the usage of every key, in the order the keys
+// were given to NewKeyPool.
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]KeyStats, len(p.keys))
+	for i, s := range p.keys {
+		out[i] = *s
+	}
+	return out
+}
+
+// Len returns the number of keys in the pool.
+func (p *KeyPool) Len() int {
+	return len(p.keys)
+}
+
+// acquire picks the key for the next request and counts it as used. It
+// returns "" if the pool is empty.
+func (p *KeyPool) acquire() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.keys) == 0 {
+		return ""
+	}
+
+	now := time.Now()
+	var pick *KeyStats
+	for i := range p.keys {
+		idx := i
+		if p.strategy == RoundRobin {
+			idx = (p.next + i) % len(p.keys)
+		}
+		s := p.keys[idx]
+		if now.Before(s.CooldownUntil) {
+			continue
+		}
+		if pick == nil || (p.strategy == LeastUsed && s.Requests < pick.Requests) {
+			pick = s
+			p.next = idx + 1
+		}
+		if p.strategy == RoundRobin {
+			break
+		}
+	}
+
+	if pick == nil {
+		pick = p.keys[0]
+		for _, s := range p.keys[1:] {
+			if s.CooldownUntil.Before(pick.CooldownUntil) {
+				pick = s
+			}
+		}
+	}
+
+	pick.Requests++
+	pick.LastUsed = now
+	return pick.Key
+}
+
+// report records the response a key got, putting it on cool-down if the API
+// rejected it.
+func (p *KeyPool) report(key string, status int, header http.Header) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s, ok := p.byKey[key]
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	cooldown := p.cooldown
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		s.Unauthorized++
+	case http.StatusTooManyRequests:
+		s.RateLimited++
+		if d, ok := parseRetryAfter(header.Get("Retry-After"), now); ok && d > cooldown {
+			cooldown = d
+		}
+	default:
+		return
+	}
+	s.CooldownUntil = now.Add(cooldown)
+}
+
+// apiKeyFor returns the key to send with the next request.
+func (c *Client) apiKeyFor() string {
+	if c.keys != nil && c.keys.Len() > 0 {
+		return c.keys.acquire()
+	}
+	return c.apiKey
+}
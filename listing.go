@@ -0,0 +1,158 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Listing is an active Seaport listing as returned by the v2 API.
+type Listing struct {
+	OrderHash       string       `json:"order_hash" bson:"order_hash"`
+	Chain           Chain        `json:"chain" bson:"chain"`
+	Type            string       `json:"type" bson:"type"`
+	Price           ListingPrice `json:"price" bson:"price"`
+	ProtocolData    ProtocolData `json:"protocol_data" bson:"protocol_data"`
+	ProtocolAddress Address      `json:"protocol_address" bson:"protocol_address"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// ListingPrice wraps the current price of a listing.
+type ListingPrice struct {
+	Current Price `json:"current" bson:"current"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// Price is an amount of a currency, in the currency's smallest unit.
+type Price struct {
+	Currency string `json:"currency" bson:"currency"`
+	Decimals int64  `json:"decimals" bson:"decimals"`
+	Value    Number `json:"value" bson:"value"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// Offer is an active Seaport offer as returned by the v2 API.
+type Offer struct {
+	OrderHash       string         `json:"order_hash" bson:"order_hash"`
+	Chain           Chain          `json:"chain" bson:"chain"`
+	Criteria        *OfferCriteria `json:"criteria" bson:"criteria"`
+	Price           Price          `json:"price" bson:"price"`
+	ProtocolData    ProtocolData   `json:"protocol_data" bson:"protocol_data"`
+	ProtocolAddress Address        `json:"protocol_address" bson:"protocol_address"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// OfferCriteria restricts a collection or trait offer.
+type OfferCriteria struct {
+	Collection struct {
+		Slug string `json:"slug" bson:"slug"`
+	} `json:"collection" bson:"collection"`
+	Contract struct {
+		Address Address `json:"address" bson:"address"`
+	} `json:"contract" bson:"contract"`
+	Trait *struct {
+		Type  string `json:"type" bson:"type"`
+		Value string `json:"value" bson:"value"`
+	} `json:"trait" bson:"trait"`
+	EncodedTokenIDs string `json:"encoded_token_ids" bson:"encoded_token_ids"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// ProtocolData is a signed Seaport order.
+type ProtocolData struct {
+	Parameters OrderParameters `json:"parameters" bson:"parameters"`
+	Signature  string          `json:"signature" bson:"signature"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// OrderParameters are the parameters of a Seaport order.
+type OrderParameters struct {
+	Offerer                         Address             `json:"offerer" bson:"offerer"`
+	Offer                           []OfferItem         `json:"offer" bson:"offer"`
+	Consideration                   []ConsiderationItem `json:"consideration" bson:"consideration"`
+	StartTime                       string              `json:"startTime" bson:"startTime"`
+	EndTime                         string              `json:"endTime" bson:"endTime"`
+	OrderType                       int                 `json:"orderType" bson:"orderType"`
+	Zone                            Address             `json:"zone" bson:"zone"`
+	ZoneHash                        string              `json:"zoneHash" bson:"zoneHash"`
+	Salt                            string              `json:"salt" bson:"salt"`
+	ConduitKey                      string              `json:"conduitKey" bson:"conduitKey"`
+	TotalOriginalConsiderationItems int                 `json:"totalOriginalConsiderationItems" bson:"totalOriginalConsiderationItems"`
+	Counter                         any                 `json:"counter" bson:"counter"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// OfferItem is an item offered by the maker of a Seaport order.
+type OfferItem struct {
+	ItemType             int     `json:"itemType" bson:"itemType"`
+	Token                Address `json:"token" bson:"token"`
+	IdentifierOrCriteria string  `json:"identifierOrCriteria" bson:"identifierOrCriteria"`
+	StartAmount          Number  `json:"startAmount" bson:"startAmount"`
+	EndAmount            Number  `json:"endAmount" bson:"endAmount"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// ConsiderationItem is an item the maker of a Seaport order receives.
+type ConsiderationItem struct {
+	OfferItem
+	Recipient Address `json:"recipient" bson:"recipient"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// ListingsPage is a page of listings.
+type ListingsPage struct {
+	Listings []Listing `json:"listings" bson:"listings"`
+	Next     string    `json:"next" bson:"next"`
+}
+
+// OffersPage is a page of offers.
+type OffersPage struct {
+	Offers []Offer `json:"offers" bson:"offers"`
+	Next   string  `json:"next" bson:"next"`
+}
+
+// GetAllListings retrieves a page of the active listings of a collection.
+func (c *Client) GetAllListings(ctx context.Context, slug string, params ListParams) (*ListingsPage, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+
+	path := fmt.Sprintf(collectionListingsV2EP, url.PathEscape(slug))
+	if q := params.values().Encode(); q != "" {
+		path += "?" + q
+	}
+
+	var page ListingsPage
+	if err := c.getJSON(ctx, "GetAllListings", path, &page); err != nil {
+		return nil, fmt.Errorf("failed to get listings: %w", err)
+	}
+	return &page, nil
+}
+
+// GetAllOffers retrieves a page of the active offers on a collection.
+func (c *Client) GetAllOffers(ctx context.Context, slug string, params ListParams) (*OffersPage, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+
+	path := fmt.Sprintf(collectionOffersV2EP, url.PathEscape(slug))
+	if q := params.values().Encode(); q != "" {
+		path += "?" + q
+	}
+
+	var page OffersPage
+	if err := c.getJSON(ctx, "GetAllOffers", path, &page); err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+	return &page, nil
+}
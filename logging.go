@@ -0,0 +1,152 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLoggedBody caps how much of a response body is dumped at debug level.
+const maxLoggedBody = 64 << 10
+
+const redacted = "[REDACTED]"
+
+// WithLogger makes the client log every HTTP request it sends, retries
+// included, to logger. Successful requests are logged at info level and
+// failed ones at warn level, with the operation, method, path, status,
+// latency, attempt and any page offset or cursor. At debug level the request
+// headers and response body are added. API keys never appear in the output.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+type operationKey struct{}
+
+func withOperation(ctx context.Context, op string) context.Context {
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+func operationFrom(ctx context.Context) string {
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+// logRequest records a single attempt of a request.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, attempt int, latency time.Duration, resp *response, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	level := slog.LevelInfo
+	msg := "opensea request"
+	if err != nil {
+		level = slog.LevelWarn
+		msg = "opensea request failed"
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	var (
+		status = statusOf(resp, err)
+		header http.Header
+		body   []byte
+	)
+	var apiErr *APIError
+	switch {
+	case resp != nil:
+		header, body = resp.header, resp.body
+	case errors.As(err, &apiErr):
+		header, body = apiErr.Header, apiErr.Body
+	}
+
+	secrets := c.secrets(req)
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("status", status),
+		slog.Duration("latency", latency),
+		slog.Int("attempt", attempt),
+	}
+	if op := operationFrom(ctx); op != "" {
+		attrs = append(attrs, slog.String("operation", op))
+	}
+	q := req.URL.Query()
+	if v := q.Get("offset"); v != "" {
+		attrs = append(attrs, slog.String("offset", v))
+	}
+	for _, name := range []string{"cursor", "next"} {
+		if v := q.Get(name); v != "" {
+			attrs = append(attrs, slog.String("cursor", v))
+			break
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redact(err.Error(), secrets)))
+	}
+
+	if c.logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs,
+			slog.String("query", redact(req.URL.RawQuery, secrets)),
+			slog.Any("request_header", redactHeader(req.Header, secrets)),
+			slog.Any("response_header", redactHeader(header, secrets)),
+		)
+		if len(body) > maxLoggedBody {
+			body = body[:maxLoggedBody]
+		}
+		attrs = append(attrs, slog.String("response_body", redact(string(body), secrets)))
+	}
+
+	c.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// statusOf returns the HTTP status of a request attempt, or 0 if no response
+// was received.
+func statusOf(resp *response, err error) int {
+	if resp != nil {
+		return resp.status
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// secrets returns the API keys that may appear in a log record of req.
+func (c *Client) secrets(req *http.Request) []string {
+	var out []string
+	if c.apiKey != "" {
+		out = append(out, c.apiKey)
+	}
+	if k := req.Header.Get("X-API-KEY"); k != "" && k != c.apiKey {
+		out = append(out, k)
+	}
+	return out
+}
+
+func redact(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	return s
+}
+
+func redactHeader(h http.Header, secrets []string) http.Header {
+	out := make(http.Header, len(h))
+	for k, vs := range h {
+		if k == "X-Api-Key" || k == "Authorization" {
+			out[k] = []string{redacted}
+			continue
+		}
+		for _, v := range vs {
+			out[k] = append(out[k], redact(v, secrets))
+		}
+	}
+	return out
+}
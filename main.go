@@ -4,118 +4,40 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"math/big"
-	"net"
-	"net/http"
-	"time"
 )
 
-var (
-	mainnetAPI          = "https://api.opensea.io"
-	rinkebyAPI          = "https://rinkeby-api.opensea.io"
-	basePath            = "/api/v1"
-	singleAssetEndpoint = basePath + "/asset"
-	singleContractEndpoint = basePath + "/asset_contract"
-)
-
-type Opensea struct {
-	API        string
-	APIKey     string
-	httpClient *http.Client
-}
-
-func (o Opensea) GetSingleAsset(assetContractAddress string, tokenID *big.Int) (*Asset, error) {
+func (c *Client) GetSingleAsset(assetContractAddress string, tokenID TokenID) (*Asset, error) {
 	ctx := context.TODO()
-	return o.GetSingleAssetWithContext(ctx, assetContractAddress, tokenID)
+	return c.GetSingleAssetWithContext(ctx, assetContractAddress, tokenID)
 }
 
-func (o Opensea) GetSingleAssetWithContext(ctx context.Context, assetContractAddress string, tokenID *big.Int) (*Asset, error) {
+func (c *Client) GetSingleAssetWithContext(ctx context.Context, assetContractAddress string, tokenID TokenID) (*Asset, error) {
+	if !tokenID.IsSet() {
+		return nil, fmt.Errorf("token ID cannot be empty")
+	}
 	path := fmt.Sprintf("%s/%s/%s", singleAssetEndpoint, assetContractAddress, tokenID.String())
-	b, err := o.GetPath(ctx, path)
+	b, err := c.get(ctx, "GetSingleAsset", path)
 	if err != nil {
 		return nil, err
 	}
 	ret := new(Asset)
-	return ret, json.Unmarshal(b, ret)
-}
-
-func (o Opensea) GetPath(ctx context.Context, path string) ([]byte, error) {
-	return o.getURL(ctx, o.API+path)
-}
-
-func (o Opensea) getURL(ctx context.Context, url string) ([]byte, error) {
-	client := o.httpClient
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(b, ret); err != nil {
+		return ret, err
 	}
-	req.Header.Add("X-API-KEY", o.APIKey)
-	req.Header.Add("Accept", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		e := new(errorResponse)
-		err = json.Unmarshal(body, e)
-		if err != nil {
-			return nil, err
-		}
-		if !e.Success {
-			return nil, e
-		}
-
-		return nil, fmt.Errorf("backend returns status %d msg: %s", resp.StatusCode, string(body))
-	}
-
-	return body, nil
+	ret.setChain(c.chain)
+	return ret, nil
 }
 
-func (o *Opensea) SetHttpClient(httpClient *http.Client) {
-	o.httpClient = httpClient
+// NewOpensea initializes a client for the mainnet API.
+//
+// Deprecated: use NewClient, or NewNetworkClient with Mainnet.
+func NewOpensea(apiKey string) *Client {
+	return NewClient("", apiKey, WithNetwork(Mainnet))
 }
 
-func defaultHttpClient() *http.Client {
-	client := new(http.Client)
-	var transport http.RoundTripper = &http.Transport{
-		Proxy:              http.ProxyFromEnvironment,
-		DisableKeepAlives:  false,
-		DisableCompression: false,
-		DialContext: (&net.Dialer{
-			Timeout:   30 * time.Second,
-			KeepAlive: 300 * time.Second,
-			DualStack: true,
-		}).DialContext,
-		ForceAttemptHTTP2:     true,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   5 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
-	}
-	client.Transport = transport
-	return client
-}
-
-func NewOpensea(apiKey string) *Opensea {
-	return &Opensea{
-		API:        mainnetAPI,
-		APIKey:     apiKey,
-		httpClient: defaultHttpClient(),
-	}
-}
-
-func NewTestOpensea(apiKey string) *Opensea {
-	return &Opensea{
-		API:        rinkebyAPI,
-		APIKey:     apiKey,
-		httpClient: defaultHttpClient(),
-	}
+// NewTestOpensea initializes a client for OpenSea's testnets.
+//
+// Deprecated: use NewNetworkClient with Sepolia or another testnet.
+func NewTestOpensea(apiKey string) *Client {
+	return NewClient("", apiKey, WithNetwork(Testnet))
 }
@@ -0,0 +1,230 @@
+package opensea
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics receives measurements from a client. Implementations must be safe
+// for concurrent use. operation is the name of the client method that made
+// the request, e.g. "GetNFT".
+type Metrics interface {
+	// ObserveRequest is called once per HTTP request sent, retries included.
+	// status is 0 if no response was received.
+	ObserveRequest(operation string, status int, latency time.Duration)
+	// ObserveRetry is called each time a failed request is about to be
+	// retried.
+	ObserveRetry(operation string)
+	// ObserveCache is called for every lookup of a cacheable path. hit
+	// reports whether the body was served from the cache, either fresh or
+	// after a 304 revalidation.
+	ObserveCache(operation string, hit bool)
+	// ObserveRateLimitWait is called with the time spent waiting on the
+	// rate limiter before a request, when the limiter made it wait.
+	// Requests the limiter let straight through are not reported.
+	ObserveRateLimitWait(wait time.Duration)
+	// ObserveCoalesced is called when a call was answered with the result
+	// of an identical call already in flight.
+	ObserveCoalesced(operation string)
+}
+
+// WithMetrics makes the client report to m.
+func WithMetrics(m Metrics) Option {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+// histogram of a PrometheusMetrics.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics is a Metrics that keeps its series in memory and serves
+// them in the Prometheus text exposition format. Mount it on a mux to have
+// it scraped.
+type PrometheusMetrics struct {
+	mu      sync.Mutex
+	buckets []float64
+
+	requests    map[[2]string]uint64 // operation, status
+	errors      map[string]uint64    // status
+	latencies   map[string]*histogram
+	retries     map[string]uint64
+	cacheHits   map[string]uint64
+	cacheMisses map[string]uint64
+	coalesced   map[string]uint64
+	waitCount   uint64
+	waitSeconds float64
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics returns an empty PrometheusMetrics. buckets are the
+// latency histogram bounds in seconds; none selects DefaultLatencyBuckets.
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+
+	return &PrometheusMetrics{
+		buckets:     buckets,
+		requests:    map[[2]string]uint64{},
+		errors:      map[string]uint64{},
+		latencies:   map[string]*histogram{},
+		retries:     map[string]uint64{},
+		cacheHits:   map[string]uint64{},
+		cacheMisses: map[string]uint64{},
+		coalesced:   map[string]uint64{},
+	}
+}
+
+func (m *PrometheusMetrics) ObserveRequest(operation string, status int, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	code := strconv.Itoa(status)
+	m.requests[[2]string{operation, code}]++
+	if status == 0 || status >= 400 {
+		m.errors[code]++
+	}
+
+	h, ok := m.latencies[operation]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.latencies[operation] = h
+	}
+	s := latency.Seconds()
+	if i, _ := slices.BinarySearch(m.buckets, s); i < len(m.buckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += s
+}
+
+func (m *PrometheusMetrics) ObserveRetry(operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries[operation]++
+}
+
+func (m *PrometheusMetrics) ObserveCache(operation string, hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if hit {
+		m.cacheHits[operation]++
+	} else {
+		m.cacheMisses[operation]++
+	}
+}
+
+func (m *PrometheusMetrics) ObserveRateLimitWait(wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.waitCount++
+	m.waitSeconds += wait.Seconds()
+}
+
+func (m *PrometheusMetrics) ObserveCoalesced(operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.coalesced[operation]++
+}
+
+// ServeHTTP writes every series in the Prometheus text format.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes every series in the Prometheus text format to w.
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	header(&b, "opensea_requests_total", "counter", "HTTP requests sent to the OpenSea API.")
+	for _, k := range sortedKeys(m.requests, func(a, b [2]string) int {
+		return strings.Compare(a[0]+"\x00"+a[1], b[0]+"\x00"+b[1])
+	}) {
+		fmt.Fprintf(&b, "opensea_requests_total{operation=%s,status=%s} %d\n", quote(k[0]), quote(k[1]), m.requests[k])
+	}
+
+	header(&b, "opensea_request_errors_total", "counter", "Failed requests by HTTP status, 0 when no response was received.")
+	for _, k := range sortedKeys(m.errors, strings.Compare) {
+		fmt.Fprintf(&b, "opensea_request_errors_total{status=%s} %d\n", quote(k), m.errors[k])
+	}
+
+	header(&b, "opensea_request_duration_seconds", "histogram", "Latency of requests to the OpenSea API.")
+	for _, op := range sortedKeys(m.latencies, strings.Compare) {
+		h := m.latencies[op]
+		var cum uint64
+		for i, le := range m.buckets {
+			cum += h.counts[i]
+			fmt.Fprintf(&b, "opensea_request_duration_seconds_bucket{operation=%s,le=%s} %d\n",
+				quote(op), quote(strconv.FormatFloat(le, 'g', -1, 64)), cum)
+		}
+		fmt.Fprintf(&b, "opensea_request_duration_seconds_bucket{operation=%s,le=\"+Inf\"} %d\n", quote(op), h.count)
+		fmt.Fprintf(&b, "opensea_request_duration_seconds_sum{operation=%s} %g\n", quote(op), h.sum)
+		fmt.Fprintf(&b, "opensea_request_duration_seconds_count{operation=%s} %d\n", quote(op), h.count)
+	}
+
+	header(&b, "opensea_retries_total", "counter", "Requests retried after a failure.")
+	writeCounters(&b, "opensea_retries_total", m.retries)
+
+	header(&b, "opensea_cache_hits_total", "counter", "Cacheable requests served from the cache.")
+	writeCounters(&b, "opensea_cache_hits_total", m.cacheHits)
+
+	header(&b, "opensea_cache_misses_total", "counter", "Cacheable requests that went to the API.")
+	writeCounters(&b, "opensea_cache_misses_total", m.cacheMisses)
+
+	header(&b, "opensea_coalesced_total", "counter", "Calls answered by an identical call already in flight.")
+	writeCounters(&b, "opensea_coalesced_total", m.coalesced)
+
+	header(&b, "opensea_rate_limit_waits_total", "counter", "Requests that waited on the rate limiter.")
+	fmt.Fprintf(&b, "opensea_rate_limit_waits_total %d\n", m.waitCount)
+	header(&b, "opensea_rate_limit_wait_seconds_total", "counter", "Time spent waiting on the rate limiter.")
+	fmt.Fprintf(&b, "opensea_rate_limit_wait_seconds_total %g\n", m.waitSeconds)
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func header(b *strings.Builder, name, typ, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func writeCounters(b *strings.Builder, name string, counters map[string]uint64) {
+	for _, op := range sortedKeys(counters, strings.Compare) {
+		fmt.Fprintf(b, "%s{operation=%s} %d\n", name, quote(op), counters[op])
+	}
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, cmp)
+	return keys
+}
+
+// quote quotes a label value as the exposition format requires.
+func quote(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	v = strings.ReplaceAll(v, "\n", `\n`)
+	return `"` + v + `"`
+}
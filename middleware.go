@@ -0,0 +1,165 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Request describes a single endpoint call as it passes through the
+// middleware chain. Middlewares may modify Path, Query and Header before
+// calling the next handler.
+type Request struct {
+	// Operation is the name of the client method making the call, e.g.
+	// "GetNFT" or "ListEventsByCollection".
+	Operation string
+	Method    string
+	Path      string
+	Query     url.Values
+	Header    http.Header
+
+	// stream, if set, consumes the response body in place of Response.Body.
+	stream func(io.Reader) error
+}
+
+// URL returns the path and encoded query of the request.
+func (r *Request) URL() string {
+	if q := r.Query.Encode(); q != "" {
+		return r.Path + "?" + q
+	}
+	return r.Path
+}
+
+// Response is the outcome of an endpoint call. It is also returned alongside
+// an *APIError, so middlewares see the status of failed calls. Body is nil
+// for calls whose body was decoded as it streamed in.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Handler performs an endpoint call.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to observe or alter endpoint calls.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares to the client. The first middleware is the
+// outermost: it sees a call first and its response last. Middlewares run
+// once per endpoint call, around the cache and any retries.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// CallInfo is what LoggingMiddleware reports about a finished call.
+type CallInfo struct {
+	Operation  string
+	Path       string
+	Query      url.Values
+	StatusCode int
+	Latency    time.Duration
+	Err        error
+}
+
+// LoggingMiddleware calls log once every endpoint call has finished.
+func LoggingMiddleware(log func(CallInfo)) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+
+			info := CallInfo{
+				Operation: req.Operation,
+				Path:      req.Path,
+				Query:     req.Query,
+				Latency:   time.Since(start),
+				Err:       err,
+			}
+			if resp != nil {
+				info.StatusCode = resp.StatusCode
+			}
+			log(info)
+			return resp, err
+		}
+	}
+}
+
+// HeaderMiddleware sets header on every request, replacing any value the
+// client would otherwise send for the same key.
+func HeaderMiddleware(header http.Header) Middleware {
+	return HeaderFuncMiddleware(func(ctx context.Context, h http.Header) {
+		for k, v := range header {
+			h[http.CanonicalHeaderKey(k)] = v
+		}
+	})
+}
+
+// HeaderFuncMiddleware calls set with the headers of every request, for
+// values computed per call such as request IDs or short-lived tokens.
+func HeaderFuncMiddleware(set func(ctx context.Context, header http.Header)) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			set(ctx, req.Header)
+			return next(ctx, req)
+		}
+	}
+}
+
+// handler builds the middleware chain around the client's transport.
+func (c *Client) handler() Handler {
+	h := c.do
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
+
+// do is the innermost Handler: it serves req from the cache or the API,
+// sharing the call with identical requests in flight if coalescing is on.
+func (c *Client) do(ctx context.Context, req *Request) (*Response, error) {
+	ctx = withOperation(ctx, req.Operation)
+	if c.flights == nil || req.Method != http.MethodGet || req.stream != nil || coalescingDisabled(ctx) {
+		return c.send(ctx, req)
+	}
+
+	resp, err, shared := c.flights.do(ctx, req.URL(), func() (*Response, error) {
+		return c.send(ctx, req)
+	})
+	if shared && c.metrics != nil {
+		c.metrics.ObserveCoalesced(req.Operation)
+	}
+	return resp, err
+}
+
+// send performs req without coalescing.
+func (c *Client) send(ctx context.Context, req *Request) (*Response, error) {
+	path := req.URL()
+
+	var (
+		resp *response
+		err  error
+	)
+	if ttl := c.cacheTTL(path); ttl > 0 && req.stream == nil {
+		resp, err = c.getCached(ctx, path, req.Header, ttl)
+	} else {
+		resp, err = c.fetch(ctx, path, req.Header, "", req.stream)
+	}
+
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return &Response{StatusCode: apiErr.StatusCode, Header: apiErr.Header, Body: apiErr.Body}, err
+		}
+		return nil, err
+	}
+	return &Response{StatusCode: resp.status, Header: resp.header, Body: resp.body}, nil
+}
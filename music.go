@@ -42,7 +42,7 @@ func (c *Client) GetMusic(ctx context.Context, filter MusicFilter) (*NFTResponse
 	filter.AnimationURLExists = true
 
 	query := buildMusicQuery(musicEP, filter)
-	return c.fetchNFTs(ctx, query)
+	return c.fetchNFTs(ctx, "GetMusic", query)
 }
 
 // buildMusicQuery constructs the query string for music NFT requests
@@ -79,7 +79,7 @@ func (c *Client) GetTrendingMusic(ctx context.Context, filter TrendingMusicFilte
 	query := fmt.Sprintf("%s/trending?limit=%d&time_window=%s&animation_url_exists=true&order_by=sale_count",
 		musicEP, filter.Limit, filter.TimeWindow)
 
-	resp, err := c.get(ctx, query)
+	resp, err := c.get(ctx, "GetTrendingMusic", query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trending music NFTs: %w", err)
 	}
@@ -0,0 +1,167 @@
+package opensea
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownNetwork is returned for a network that is not registered.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// Network names an OpenSea deployment registered with RegisterNetwork.
+type Network string
+
+// Built-in networks. The testnets share OpenSea's testnets deployment and
+// differ only in their default chain.
+const (
+	Mainnet     Network = "mainnet"
+	Testnet     Network = "testnet"
+	Sepolia     Network = "sepolia"
+	Amoy        Network = "amoy"
+	BaseSepolia Network = "base_sepolia"
+)
+
+// Rinkeby was OpenSea's Rinkeby deployment, shut down along with the Rinkeby
+// chain. It now resolves to Testnet.
+//
+// Deprecated: use Sepolia or another testnet.
+const Rinkeby Network = "rinkeby"
+
+// NetworkInfo describes an OpenSea deployment.
+type NetworkInfo struct {
+	Name Network
+	// APIURL is the scheme and host of the API, e.g. https://api.opensea.io.
+	APIURL string
+	// Chain is the chain requests default to on this network.
+	Chain Chain
+	// PermalinkHost is the scheme and host of the website, used to build
+	// links to items.
+	PermalinkHost string
+	Testnet       bool
+}
+
+var (
+	networksMu sync.RWMutex
+	networks   = map[Network]NetworkInfo{
+		Mainnet:     {Name: Mainnet, APIURL: mainnetAPI, Chain: ChainEthereum, PermalinkHost: mainnetWeb},
+		Testnet:     {Name: Testnet, APIURL: testnetAPI, Chain: ChainSepolia, PermalinkHost: testnetWeb, Testnet: true},
+		Sepolia:     {Name: Sepolia, APIURL: testnetAPI, Chain: ChainSepolia, PermalinkHost: testnetWeb, Testnet: true},
+		Amoy:        {Name: Amoy, APIURL: testnetAPI, Chain: ChainAmoy, PermalinkHost: testnetWeb, Testnet: true},
+		BaseSepolia: {Name: BaseSepolia, APIURL: testnetAPI, Chain: ChainBaseSepolia, PermalinkHost: testnetWeb, Testnet: true},
+	}
+	networkAliases = map[Network]Network{
+		Rinkeby: Testnet,
+	}
+)
+
+// RegisterNetwork adds a network, or replaces the one of the same name, so it
+// can be selected with WithNetwork. This is how a client is pointed at a
+// local mock or a private deployment. An empty Chain defaults to ethereum and
+// an empty PermalinkHost to the mainnet website.
+func RegisterNetwork(info NetworkInfo) error {
+	if info.Name == "" {
+		return fmt.Errorf("network name cannot be empty")
+	}
+	u, err := url.Parse(info.APIURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid API URL %q for network %s", info.APIURL, info.Name)
+	}
+	if info.Chain == "" {
+		info.Chain = ChainEthereum
+	}
+	if info.PermalinkHost == "" {
+		info.PermalinkHost = mainnetWeb
+	}
+	info.APIURL = strings.TrimSuffix(info.APIURL, "/")
+	info.PermalinkHost = strings.TrimSuffix(info.PermalinkHost, "/")
+
+	networksMu.Lock()
+	defer networksMu.Unlock()
+	networks[info.Name] = info
+	return nil
+}
+
+// LookupNetwork returns the registered network of the given name.
+func LookupNetwork(name Network) (NetworkInfo, error) {
+	networksMu.RLock()
+	defer networksMu.RUnlock()
+
+	if alias, ok := networkAliases[name]; ok {
+		name = alias
+	}
+	info, ok := networks[name]
+	if !ok {
+		return NetworkInfo{}, fmt.Errorf("%w: %q", ErrUnknownNetwork, string(name))
+	}
+	return info, nil
+}
+
+// Networks returns every registered network, sorted by name.
+func Networks() []NetworkInfo {
+	networksMu.RLock()
+	defer networksMu.RUnlock()
+
+	out := make([]NetworkInfo, 0, len(networks))
+	for _, info := range networks {
+		out = append(out, info)
+	}
+	slices.SortFunc(out, func(a, b NetworkInfo) int {
+		return strings.Compare(string(a.Name), string(b.Name))
+	})
+	return out
+}
+
+// Permalink returns the website URL of an item on the network.
+func (n NetworkInfo) Permalink(chain Chain, contract Address, tokenID TokenID) string {
+	if chain == "" {
+		chain = n.Chain
+	}
+	return fmt.Sprintf("%s/assets/%s/%s/%s", n.PermalinkHost, chain, contract, tokenID)
+}
+
+// WithNetwork points the client at the API host of a registered network and
+// makes the network's chain the default. A later WithBaseURL or WithChain
+// overrides either. An unknown network is an error: NewNetworkClient returns
+// it, and a client from NewClient fails every request with it rather than
+// falling back to mainnet.
+func WithNetwork(network Network) Option {
+	return func(c *Client) {
+		info, err := LookupNetwork(network)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.network = info
+		c.baseURL = info.APIURL
+		c.chain = info.Chain
+	}
+}
+
+// NewNetworkClient creates a client for a registered network authenticated
+// with apiKey.
+func NewNetworkClient(network Network, apiKey string, opts ...Option) (*Client, error) {
+	c := NewClient("", apiKey, append([]Option{WithNetwork(network)}, opts...)...)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c, nil
+}
+
+// Network returns the network the client was configured for. Clients
+// created without WithNetwork report mainnet.
+func (c *Client) Network() NetworkInfo {
+	return c.network
+}
+
+// Permalink returns the website URL of an item on the client's network. An
+// empty chain selects the client's chain.
+func (c *Client) Permalink(chain Chain, contract Address, tokenID TokenID) string {
+	if chain == "" {
+		chain = c.chain
+	}
+	return c.network.Permalink(chain, contract, tokenID)
+}
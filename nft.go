@@ -4,17 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // NFTFilter represents parameters for filtering NFTs
 type NFTFilter struct {
-	Collection string   `json:"collection,omitempty"`
-	TokenIDs   []string `json:"token_ids,omitempty"`
-	Owner      string   `json:"owner,omitempty"`
-	Limit      int      `json:"limit,omitempty"`
-	Offset     int      `json:"offset,omitempty"`
-	OrderBy    string   `json:"order_by,omitempty"`        // created_date, sale_date, etc.
-	OrderDir   string   `json:"order_direction,omitempty"` // desc or asc
+	Collection           string    `json:"collection,omitempty"`
+	AssetContractAddress string    `json:"asset_contract_address,omitempty"`
+	TokenIDs             []TokenID `json:"token_ids,omitempty"`
+	Owner                string    `json:"owner,omitempty"`
+	Limit                int       `json:"limit,omitempty"`
+	Offset               int       `json:"offset,omitempty"`
+	Cursor               string    `json:"cursor,omitempty"`
+	OrderBy              string    `json:"order_by,omitempty"`        // created_date, sale_date, etc.
+	OrderDir             string    `json:"order_direction,omitempty"` // desc or asc
 }
 
 // NFTResponse represents the API response for NFTs
@@ -24,16 +27,16 @@ type NFTResponse struct {
 }
 
 // GetNFT retrieves a single NFT by contract address and token ID
-func (c *Client) GetNFT(ctx context.Context, contractAddress, tokenID string) (*Asset, error) {
+func (c *Client) GetNFT(ctx context.Context, contractAddress string, tokenID TokenID) (*Asset, error) {
 	if contractAddress == "" {
 		return nil, fmt.Errorf("contract address cannot be empty")
 	}
-	if tokenID == "" {
+	if !tokenID.IsSet() {
 		return nil, fmt.Errorf("token ID cannot be empty")
 	}
 
 	path := fmt.Sprintf("%s/%s/%s", assetEP, contractAddress, tokenID)
-	resp, err := c.get(ctx, path)
+	resp, err := c.get(ctx, "GetNFT", path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get NFT: %w", err)
 	}
@@ -42,28 +45,40 @@ func (c *Client) GetNFT(ctx context.Context, contractAddress, tokenID string) (*
 	if err := json.Unmarshal(resp, &asset); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal NFT: %w", err)
 	}
+	asset.setChain(c.chain)
 
 	return &asset, nil
 }
 
 // GetNFTs retrieves multiple NFTs based on the provided filters
 func (c *Client) GetNFTs(ctx context.Context, filter NFTFilter) (*NFTResponse, error) {
+	return c.fetchNFTs(ctx, "GetNFTs", filter.path())
+}
+
+// path returns the assets endpoint with the query of the filter.
+func (filter NFTFilter) path() string {
 	if filter.Limit == 0 {
 		filter.Limit = 20 // Default limit
 	}
 
 	// Construct query parameters
-	query := fmt.Sprintf("%s?limit=%d", assetEP, filter.Limit)
+	query := fmt.Sprintf("%s?limit=%d", assetsEP, filter.Limit)
 
 	if filter.Collection != "" {
 		query += fmt.Sprintf("&collection=%s", filter.Collection)
 	}
+	if filter.AssetContractAddress != "" {
+		query += fmt.Sprintf("&asset_contract_address=%s", filter.AssetContractAddress)
+	}
 	if filter.Owner != "" {
 		query += fmt.Sprintf("&owner=%s", filter.Owner)
 	}
 	if filter.Offset > 0 {
 		query += fmt.Sprintf("&offset=%d", filter.Offset)
 	}
+	if filter.Cursor != "" {
+		query += fmt.Sprintf("&cursor=%s", url.QueryEscape(filter.Cursor))
+	}
 	if len(filter.TokenIDs) > 0 {
 		for _, id := range filter.TokenIDs {
 			query += fmt.Sprintf("&token_ids=%s", id)
@@ -76,7 +91,12 @@ func (c *Client) GetNFTs(ctx context.Context, filter NFTFilter) (*NFTResponse, e
 		query += fmt.Sprintf("&order_direction=%s", filter.OrderDir)
 	}
 
-	resp, err := c.get(ctx, query)
+	return query
+}
+
+// fetchNFTs requests a list of assets and decodes the paged response
+func (c *Client) fetchNFTs(ctx context.Context, op, query string) (*NFTResponse, error) {
+	resp, err := c.get(ctx, op, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get NFTs: %w", err)
 	}
@@ -85,10 +105,27 @@ func (c *Client) GetNFTs(ctx context.Context, filter NFTFilter) (*NFTResponse, e
 	if err := json.Unmarshal(resp, &nftResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal NFTs response: %w", err)
 	}
+	for i := range nftResp.Assets {
+		nftResp.Assets[i].setChain(c.chain)
+	}
 
 	return &nftResp, nil
 }
 
+// NFTsPager returns a Pager following the Next cursor of GetNFTs, starting at
+// filter.Cursor.
+func (c *Client) NFTsPager(filter NFTFilter) *Pager[Asset] {
+	return NewCursorPager(filter.Cursor, func(ctx context.Context, cursor string) ([]Asset, string, error) {
+		f := filter
+		f.Cursor = cursor
+		resp, err := c.GetNFTs(ctx, f)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Assets, resp.Next, nil
+	})
+}
+
 // GetNFTsByCollection is a convenience method to get NFTs from a specific collection
 func (c *Client) GetNFTsByCollection(ctx context.Context, collectionSlug string) (*NFTResponse, error) {
 	return c.GetNFTs(ctx, NFTFilter{
@@ -110,14 +147,14 @@ func (c *Client) GetNFTsByOwner(ctx context.Context, ownerAddress string) (*NFTR
 }
 
 // GetNFTsByTokenIDs is a convenience method to get NFTs by their token IDs
-func (c *Client) GetNFTsByTokenIDs(ctx context.Context, contractAddress string, tokenIDs []string) (*NFTResponse, error) {
+func (c *Client) GetNFTsByTokenIDs(ctx context.Context, contractAddress string, tokenIDs []TokenID) (*NFTResponse, error) {
 	if contractAddress == "" {
 		return nil, fmt.Errorf("contract address cannot be empty")
 	}
 
 	return c.GetNFTs(ctx, NFTFilter{
-		Collection: contractAddress,
-		TokenIDs:   tokenIDs,
-		Limit:      len(tokenIDs),
+		AssetContractAddress: contractAddress,
+		TokenIDs:             tokenIDs,
+		Limit:                len(tokenIDs),
 	})
 }
@@ -0,0 +1,193 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// NFT is an NFT as returned by the v2 API.
+type NFT struct {
+	Identifier          TokenID    `json:"identifier" bson:"identifier"`
+	Collection          string     `json:"collection" bson:"collection"`
+	Contract            Address    `json:"contract" bson:"contract"`
+	TokenStandard       string     `json:"token_standard" bson:"token_standard"`
+	Name                string     `json:"name" bson:"name"`
+	Description         string     `json:"description" bson:"description"`
+	ImageURL            string     `json:"image_url" bson:"image_url"`
+	DisplayImageURL     string     `json:"display_image_url" bson:"display_image_url"`
+	DisplayAnimationURL string     `json:"display_animation_url" bson:"display_animation_url"`
+	MetadataURL         string     `json:"metadata_url" bson:"metadata_url"`
+	OpenseaURL          string     `json:"opensea_url" bson:"opensea_url"`
+	UpdatedAt           Timestamp  `json:"updated_at" bson:"updated_at"`
+	IsDisabled          bool       `json:"is_disabled" bson:"is_disabled"`
+	IsNSFW              bool       `json:"is_nsfw" bson:"is_nsfw"`
+	AnimationURL        string     `json:"animation_url" bson:"animation_url"`
+	IsSuspicious        bool       `json:"is_suspicious" bson:"is_suspicious"`
+	Creator             Address    `json:"creator" bson:"creator"`
+	Traits              Traits     `json:"traits" bson:"traits"`
+	Owners              []NFTOwner `json:"owners" bson:"owners"`
+	Rarity              *NFTRarity `json:"rarity" bson:"rarity"`
+	// Chain is filled in by the client when the request named the chain.
+	Chain Chain `json:"chain,omitempty" bson:"chain,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// NFTTrait is a single trait of an NFT.
+//
+// Deprecated: Use Trait.
+type NFTTrait = Trait
+
+// NFTOwner is an owner of an NFT along with the quantity it holds.
+type NFTOwner struct {
+	Address  Address `json:"address" bson:"address"`
+	Quantity int64   `json:"quantity" bson:"quantity"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// NFTRarity is the rarity ranking of an NFT within its collection.
+type NFTRarity struct {
+	StrategyID      string    `json:"strategy_id" bson:"strategy_id"`
+	StrategyVersion string    `json:"strategy_version" bson:"strategy_version"`
+	Rank            int64     `json:"rank" bson:"rank"`
+	Score           float64   `json:"score" bson:"score"`
+	CalculatedAt    Timestamp `json:"calculated_at" bson:"calculated_at"`
+	MaxRank         int64     `json:"max_rank" bson:"max_rank"`
+	TokensScored    int64     `json:"tokens_scored" bson:"tokens_scored"`
+	RankingFeatures any       `json:"ranking_features" bson:"ranking_features"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// Contract is a smart contract as returned by the v2 API.
+type Contract struct {
+	Address          Address `json:"address" bson:"address"`
+	Chain            Chain   `json:"chain" bson:"chain"`
+	Collection       string  `json:"collection" bson:"collection"`
+	ContractStandard string  `json:"contract_standard" bson:"contract_standard"`
+	Name             string  `json:"name" bson:"name"`
+	TotalSupply      int64   `json:"total_supply" bson:"total_supply"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// NFTsResponse is a page of NFTs returned by the v2 list endpoints.
+type NFTsResponse struct {
+	NFTs []NFT  `json:"nfts" bson:"nfts"`
+	Next string `json:"next" bson:"next"`
+}
+
+// ListParams pages through a v2 list endpoint.
+type ListParams struct {
+	Limit int    // page size, 1 to 200; zero uses the API default
+	Next  string // cursor returned by the previous page
+}
+
+func (p ListParams) values() url.Values {
+	q := url.Values{}
+	if p.Limit > 0 {
+		q.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Next != "" {
+		q.Set("next", p.Next)
+	}
+	return q
+}
+
+// GetNFTV2 retrieves metadata, traits, ownership and rarity of a single NFT.
+func (c *Client) GetNFTV2(ctx context.Context, chain Chain, address string, identifier TokenID) (*NFT, error) {
+	chain, err := c.resolveChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, ErrEmptyContractAddress
+	}
+	if !identifier.IsSet() {
+		return nil, fmt.Errorf("token ID cannot be empty")
+	}
+
+	out := &struct {
+		NFT NFT `json:"nft"`
+	}{}
+	path := fmt.Sprintf(chainNFTV2EP, chain, address, identifier)
+	if err := c.getJSON(ctx, "GetNFTV2", path, out); err != nil {
+		return nil, fmt.Errorf("failed to get NFT: %w", err)
+	}
+	out.NFT.Chain = chain
+	return &out.NFT, nil
+}
+
+// GetContractV2 retrieves a smart contract on the given chain.
+func (c *Client) GetContractV2(ctx context.Context, chain Chain, address string) (*Contract, error) {
+	chain, err := c.resolveChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, ErrEmptyContractAddress
+	}
+
+	var contract Contract
+	path := fmt.Sprintf(chainContractV2EP, chain, address)
+	if err := c.getJSON(ctx, "GetContractV2", path, &contract); err != nil {
+		return nil, fmt.Errorf("failed to get contract: %w", err)
+	}
+	if contract.Chain == "" {
+		contract.Chain = chain
+	}
+	return &contract, nil
+}
+
+// ListNFTsByCollection retrieves a page of the NFTs of a collection.
+func (c *Client) ListNFTsByCollection(ctx context.Context, slug string, params ListParams) (*NFTsResponse, error) {
+	if slug == "" {
+		return nil, fmt.Errorf("collection slug cannot be empty")
+	}
+	return c.listNFTs(ctx, "ListNFTsByCollection", fmt.Sprintf(collectionNFTsV2EP, url.PathEscape(slug)), "", params)
+}
+
+// ListNFTsByAccount retrieves a page of the NFTs owned by an account.
+func (c *Client) ListNFTsByAccount(ctx context.Context, chain Chain, address string, params ListParams) (*NFTsResponse, error) {
+	chain, err := c.resolveChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, fmt.Errorf("account address cannot be empty")
+	}
+	return c.listNFTs(ctx, "ListNFTsByAccount", fmt.Sprintf(accountNFTsV2EP, chain, address), chain, params)
+}
+
+// ListNFTsByContract retrieves a page of the NFTs of a contract.
+func (c *Client) ListNFTsByContract(ctx context.Context, chain Chain, address string, params ListParams) (*NFTsResponse, error) {
+	chain, err := c.resolveChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, ErrEmptyContractAddress
+	}
+	return c.listNFTs(ctx, "ListNFTsByContract", fmt.Sprintf(contractNFTsV2EP, chain, address), chain, params)
+}
+
+// listNFTs fetches a page of NFTs, recording chain on each of them unless it
+// is empty.
+func (c *Client) listNFTs(ctx context.Context, op, path string, chain Chain, params ListParams) (*NFTsResponse, error) {
+	if q := params.values().Encode(); q != "" {
+		path += "?" + q
+	}
+
+	var resp NFTsResponse
+	if err := c.getJSON(ctx, op, path, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list NFTs: %w", err)
+	}
+	for i := range resp.NFTs {
+		resp.NFTs[i].Chain = chain
+	}
+	return &resp, nil
+}
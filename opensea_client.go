@@ -2,48 +2,133 @@ package opensea
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
-// Client represents an OpenSea API client
+const defaultUserAgent = "gopenseapi"
+
+// Client represents an OpenSea API client. Every endpoint of the package is
+// exposed as a method on Client; use NewClient to construct one.
 type Client struct {
 	baseURL    string
 	apiKey     string
+	userAgent  string
+	timeout    time.Duration
 	httpClient *http.Client
+	retry      RetryPolicy
+	limiter    *RateLimiter
+	cache      *responseCache
+	chain      Chain
+	middleware []Middleware
+	keys       *KeyPool
+	logger     *slog.Logger
+	metrics    Metrics
+	breaker    *CircuitBreaker
+	flights    *flightGroup
+	network    NetworkInfo
+	// err is a configuration error recorded by an Option. It is returned by
+	// every request so a misconfigured client never reaches the API.
+	err error
 }
 
-type OpenseaClient struct {
-	API        string
-	APIKey     string
-	httpClient *http.Client
+// Opensea is the former name of Client.
+//
+// Deprecated: use Client.
+type Opensea = Client
+
+// OpenseaClient is the former name of Client.
+//
+// Deprecated: use Client.
+type OpenseaClient = Client
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithBaseURL overrides the API host the client talks to.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
 }
 
-type errorResponse struct {
-	Success bool `json:"success" bson:"success"`
+// WithAPIKey sets the key sent in the X-API-KEY header.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) {
+		c.apiKey = apiKey
+	}
 }
 
-func (e errorResponse) Error() string {
-	return "Operation unsuccessful"
+// WithHTTPClient replaces the default HTTP client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
 }
 
-func NewOpenseaMainnet(apiKey string) *OpenseaClient {
-	return &OpenseaClient{
-		API:        mainnetAPI,
-		APIKey:     apiKey,
-		httpClient: newHttpClient(),
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
 	}
 }
 
-// NewOpenseaRinkeby initializes an Opensea instance for the Rinkeby testnet.
-func NewOpenseaRinkeby(apiKey string) *OpenseaClient {
-	return &OpenseaClient{
-		API:        rinkebyAPI,
-		APIKey:     apiKey,
+// WithTimeout sets the overall timeout of a single HTTP request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// NewClient creates a client for the API at baseURL authenticated with apiKey.
+// An empty baseURL selects the mainnet API.
+func NewClient(baseURL, apiKey string, opts ...Option) *Client {
+	if baseURL == "" {
+		baseURL = mainnetAPI
+	}
+
+	c := &Client{
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+		userAgent:  defaultUserAgent,
 		httpClient: newHttpClient(),
+		chain:      ChainEthereum,
+	}
+	c.network, _ = LookupNetwork(Mainnet)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.timeout > 0 {
+		// Copy so a caller supplied client is never mutated.
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
 	}
+
+	return c
+}
+
+// NewOpenseaMainnet initializes a client for the mainnet API.
+//
+// Deprecated: use NewClient, or NewNetworkClient with Mainnet.
+func NewOpenseaMainnet(apiKey string) *Client {
+	return NewClient("", apiKey, WithNetwork(Mainnet))
+}
+
+// NewOpenseaRinkeby initializes a client for OpenSea's testnets. Rinkeby
+// itself has been shut down.
+//
+// Deprecated: use NewNetworkClient with Sepolia or another testnet.
+func NewOpenseaRinkeby(apiKey string) *Client {
+	return NewClient("", apiKey, WithNetwork(Testnet))
 }
 
 // newHttpClient creates a default HTTP client with a timeout.
@@ -51,10 +136,13 @@ func newHttpClient() *http.Client {
 	return &http.Client{
 		Timeout: 15 * time.Second,
 		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
 			DialContext: (&net.Dialer{
 				Timeout:   5 * time.Second,
 				KeepAlive: 30 * time.Second,
 			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
 			IdleConnTimeout:       30 * time.Second,
 			TLSHandshakeTimeout:   5 * time.Second,
 			ExpectContinueTimeout: 1 * time.Second,
@@ -62,21 +150,178 @@ func newHttpClient() *http.Client {
 	}
 }
 
-func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, nil)
+// SetHttpClient replaces the HTTP client used for requests.
+func (c *Client) SetHttpClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// GetPath performs a GET request against path on the configured API host and
+// returns the raw response body.
+func (c *Client) GetPath(ctx context.Context, path string) ([]byte, error) {
+	return c.get(ctx, "GetPath", path)
+}
+
+// getJSON performs a GET request and decodes the JSON response into out.
+func (c *Client) getJSON(ctx context.Context, op, path string, out any) error {
+	b, err := c.get(ctx, op, path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// get performs a GET request on behalf of the client method op and returns
+// the response body. path may carry a query string.
+func (c *Client) get(ctx context.Context, op, path string) ([]byte, error) {
+	req := &Request{Operation: op, Method: http.MethodGet, Path: path}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		query, err := url.ParseQuery(path[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse query: %w", err)
+		}
+		req.Path, req.Query = path[:i], query
+	}
+
+	resp, err := c.handler()(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// response is the part of an HTTP response the client keeps around.
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// fetch performs a GET request, retrying it according to the retry policy.
+// header is added to every attempt. If etag is set the request revalidates a
+// cached response with it, and a 304 is returned as a response rather than
+// an error. If stream is set, a successful response body is passed to it
+// instead of being read into memory.
+func (c *Client) fetch(ctx context.Context, path string, header http.Header, etag string, stream func(io.Reader) error) (*response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	for attempt := 1; ; attempt++ {
+		resp, err := c.getOnce(ctx, path, header, etag, stream, attempt)
+		if err == nil {
+			return resp, nil
+		}
+		if attempt >= c.retry.MaxAttempts || !c.retry.retryable(ctx, err) {
+			if attempt > 1 {
+				return nil, &RetryError{Attempts: attempt, Err: err}
+			}
+			return nil, err
+		}
+
+		delay := c.retry.delay(attempt, err)
+		if c.metrics != nil {
+			c.metrics.ObserveRetry(operationFrom(ctx))
+		}
+		if c.retry.OnRetry != nil {
+			c.retry.OnRetry(attempt, delay, err)
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, &RetryError{Attempts: attempt, Err: err}
+		}
+	}
+}
+
+// getOnce performs a single GET request, the attempt-th for path. Responses
+// other than 200, and 304 when revalidating etag, are reported as an
+// *APIError.
+func (c *Client) getOnce(ctx context.Context, path string, header http.Header, etag string, stream func(io.Reader) error, attempt int) (*response, error) {
+	if c.limiter != nil {
+		start := time.Now()
+		waited, err := c.limiter.wait(ctx)
+		if waited && c.metrics != nil {
+			c.metrics.ObserveRateLimitWait(time.Since(start))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.apiKey != "" {
-		req.Header.Set("X-API-KEY", c.apiKey)
+	key := c.apiKeyFor()
+	if key != "" {
+		req.Header.Set("X-API-KEY", key)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	var gen uint64
+	if c.breaker != nil {
+		if gen, err = c.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.roundTrip(req, key, etag != "", stream)
+	latency := time.Since(start)
+	if c.breaker != nil {
+		c.breaker.done(gen, err)
 	}
+	c.logRequest(ctx, req, attempt, latency, resp, err)
+	if c.metrics != nil {
+		c.metrics.ObserveRequest(operationFrom(ctx), statusOf(resp, err), latency)
+	}
+	return resp, err
+}
 
+// roundTrip sends req and reads the response, reporting it to the key pool
+// and rate limiter. A 304 is an error unless revalidating is set; a caller
+// may send its own If-None-Match, but only the cache has a body to reuse.
+func (c *Client) roundTrip(req *http.Request, key string, revalidating bool, stream func(io.Reader) error) (*response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	return io.ReadAll(resp.Body)
+	if c.keys != nil {
+		c.keys.report(key, resp.StatusCode, resp.Header)
+	}
+
+	if c.limiter != nil {
+		c.limiter.Update(resp.Header)
+	}
+
+	if stream != nil && resp.StatusCode == http.StatusOK {
+		if err := stream(resp.Body); err != nil {
+			return nil, &streamError{err}
+		}
+		return &response{status: resp.StatusCode, header: resp.Header}, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	notModified := resp.StatusCode == http.StatusNotModified && revalidating
+	if resp.StatusCode != http.StatusOK && !notModified {
+		return nil, newAPIError(req.URL.Path, resp.StatusCode, resp.Header, body)
+	}
+
+	return &response{status: resp.StatusCode, header: resp.Header, body: body}, nil
 }
@@ -5,7 +5,10 @@ import (
 	"strings"
 )
 
-// Big converts the Number to a big.Int, ignoring decimal places
+// Big converts the Number to a big.Int, ignoring decimal places.
+//
+// Deprecated: Big truncates fractions and returns nil for malformed input.
+// Use Number.Decimal instead.
 func (n Number) Big() *big.Int {
 	s := strings.Split(string(n), ".")
 	result, _ := new(big.Int).SetString(s[0], 10)
@@ -13,11 +13,11 @@ type Order struct {
 	ID    int64 `json:"id" bson:"id"`
 	Asset Asset `json:"asset" bson:"asset"`
 	// AssetBundle          interface{}          `json:"asset_bundle" bson:"asset_bundle"`
-	CreatedDate *TimeNano `json:"created_date" bson:"created_date"`
-	ClosingDate *TimeNano `json:"closing_date" bson:"closing_date"`
+	CreatedDate Timestamp `json:"created_date" bson:"created_date"`
+	ClosingDate Timestamp `json:"closing_date" bson:"closing_date"`
 	// ClosingExtendable bool      `json:"closing_extendable" bson:"closing_extendable"`
-	ExpirationTime int64 `json:"expiration_time" bson:"expiration_time"`
-	ListingTime    int64 `json:"listing_time" bson:"listing_time"`
+	ExpirationTime Timestamp `json:"expiration_time" bson:"expiration_time"`
+	ListingTime    Timestamp `json:"listing_time" bson:"listing_time"`
 	// OrderHash            string               `json:"order_hash" bson:"order_hash"`
 	// Metadata Metadata `json:"metadata" bson:"metadata"`
 	Exchange     Address `json:"exchange" bson:"exchange"`
@@ -44,7 +44,7 @@ type Order struct {
 	PaymentToken       Address   `json:"payment_token" bson:"payment_token"`
 	// PaymentTokenContract PaymentTokenContract `json:"payment_token_contract" bson:"payment_token_contract"`
 	BasePrice       Number `json:"base_price" bson:"base_price"`
-	Extra           Number `json:"extra" bson:"extra"`
+	ExtraPrice      Number `json:"extra" bson:"extra"`
 	Quantity        string `json:"quantity" bson:"quantity"`
 	Salt            Number `json:"salt" bson:"salt"`
 	V               *uint8 `json:"v" bson:"v"`
@@ -54,7 +54,10 @@ type Order struct {
 	Cancelled       bool   `json:"cancelled" bson:"cancelled"`
 	Finalized       bool   `json:"finalized" bson:"finalized"`
 	MarkedInvalid   bool   `json:"marked_invalid" bson:"marked_invalid"`
+	Chain           Chain  `json:"chain,omitempty" bson:"chain,omitempty"`
 	// PrefixedHash         string               `json:"prefixed_hash" bson:"prefixed_hash"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 func (o Order) IsPrivate() bool {
@@ -64,6 +67,35 @@ func (o Order) IsPrivate() bool {
 	return false
 }
 
+// Price returns CurrentPrice in whole units of a payment token with the
+// given number of decimals, e.g. 18 for WETH.
+func (o Order) Price(decimals int64) (Decimal, error) {
+	wei, err := o.CurrentPrice.Decimal()
+	if err != nil {
+		return Decimal{}, err
+	}
+	return FromWei(wei, decimals)
+}
+
+// signatureWordSize is the size of the r and s components of an ECDSA
+// signature.
+const signatureWordSize = 32
+
+// ValidateSignature checks that the order carries a signature whose r and
+// s components are 32 bytes each.
+func (o Order) ValidateSignature() error {
+	if o.V == nil || o.R == nil || o.S == nil {
+		return fmt.Errorf("order %d is not signed", o.ID)
+	}
+	if err := o.R.CheckLen(signatureWordSize); err != nil {
+		return fmt.Errorf("order %d signature r: %w", o.ID, err)
+	}
+	if err := o.S.CheckLen(signatureWordSize); err != nil {
+		return fmt.Errorf("order %d signature s: %w", o.ID, err)
+	}
+	return nil
+}
+
 type Side uint8
 
 const (
@@ -102,28 +134,21 @@ const (
 // 	Address string `json:"address"`
 // }
 
-func (o Opensea) GetOrders(assetContractAddress string, listedAfter int64) ([]*Order, error) {
+func (c *Client) GetOrders(assetContractAddress string, listedAfter int64) ([]*Order, error) {
 	ctx := context.TODO()
-	return o.GetOrdersWithContext(ctx, assetContractAddress, listedAfter)
+	return c.GetOrdersWithContext(ctx, assetContractAddress, listedAfter)
 }
 
-func (o Opensea) GetOrdersWithContext(ctx context.Context, assetContractAddress string, listedAfter int64) (orders []*Order, err error) {
-	offset := 0
-	limit := 100
-
-	q := url.Values{}
-	q.Set("asset_contract_address", assetContractAddress)
-	q.Set("listed_after", fmt.Sprintf("%d", listedAfter))
-	q.Set("limit", fmt.Sprintf("%d", limit))
-	q.Set("order_by", "created_date")
-	q.Set("order_direction", "asc")
-
-	orders = []*Order{}
+func (c *Client) GetOrdersWithContext(ctx context.Context, assetContractAddress string, listedAfter int64) ([]*Order, error) {
+	return c.OrdersPager(assetContractAddress, listedAfter).Collect(ctx)
+}
 
-	for true {
-		q.Set("offset", fmt.Sprintf("%d", offset))
-		path := "/wyvern/v1/orders?" + q.Encode()
-		b, err := o.GetPath(ctx, path)
+// OrdersPager returns a Pager over the orders of a contract listed after
+// listedAfter, oldest first.
+func (c *Client) OrdersPager(assetContractAddress string, listedAfter int64) *Pager[*Order] {
+	return NewOffsetPager(0, ordersPageSize, func(ctx context.Context, offset, limit int) ([]*Order, error) {
+		path := ordersPath(assetContractAddress, listedAfter, offset, limit)
+		b, err := c.get(ctx, "GetOrders", path)
 		if err != nil {
 			return nil, err
 		}
@@ -137,13 +162,26 @@ func (o Opensea) GetOrdersWithContext(ctx context.Context, assetContractAddress
 		if err != nil {
 			return nil, err
 		}
-		orders = append(orders, out.Orders...)
-
-		if len(out.Orders) < limit {
-			break
+		for _, o := range out.Orders {
+			if o.Chain == "" {
+				o.Chain = c.chain
+			}
+			o.Asset.setChain(c.chain)
 		}
-		offset += limit
-	}
+		return out.Orders, nil
+	})
+}
 
-	return
+const ordersPageSize = 100
+
+// ordersPath returns the orders endpoint with the query of a single page.
+func ordersPath(assetContractAddress string, listedAfter int64, offset, limit int) string {
+	q := url.Values{}
+	q.Set("asset_contract_address", assetContractAddress)
+	q.Set("listed_after", fmt.Sprintf("%d", listedAfter))
+	q.Set("order_by", "created_date")
+	q.Set("order_direction", "asc")
+	q.Set("limit", fmt.Sprintf("%d", limit))
+	q.Set("offset", fmt.Sprintf("%d", offset))
+	return ordersEP + "?" + q.Encode()
 }
@@ -0,0 +1,124 @@
+package opensea
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strconv"
+)
+
+// PageFunc fetches the page starting at cursor and returns its items along
+// with the cursor of the next page, or "" when there is none.
+type PageFunc[T any] func(ctx context.Context, cursor string) (items []T, next string, err error)
+
+// Pager walks a paginated endpoint one page at a time, so large result sets
+// can be streamed without holding them all in memory.
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	start string
+
+	// MaxPages stops iteration after that many pages. Zero means no limit.
+	MaxPages int
+	// MaxItems stops iteration after that many items. Zero means no limit.
+	MaxItems int
+}
+
+// NewCursorPager returns a Pager for APIs that hand out a cursor to the next
+// page. Iteration starts at cursor; pass "" for the first page.
+func NewCursorPager[T any](cursor string, fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch, start: cursor}
+}
+
+// NewOffsetPager returns a Pager for APIs paginated with offset and limit.
+// A page shorter than limit is taken to be the last one.
+func NewOffsetPager[T any](offset, limit int, fetch func(ctx context.Context, offset, limit int) ([]T, error)) *Pager[T] {
+	return &Pager[T]{
+		start: strconv.Itoa(offset),
+		fetch: func(ctx context.Context, cursor string) ([]T, string, error) {
+			off, err := strconv.Atoi(cursor)
+			if err != nil {
+				return nil, "", err
+			}
+			items, err := fetch(ctx, off, limit)
+			if err != nil || len(items) == 0 || len(items) < limit {
+				return items, "", err
+			}
+			return items, strconv.Itoa(off + len(items)), nil
+		},
+	}
+}
+
+// filter drops the items keep rejects. Pagination still follows the pages as
+// returned by the API.
+func (p *Pager[T]) filter(keep func(T) bool) *Pager[T] {
+	fetch := p.fetch
+	p.fetch = func(ctx context.Context, cursor string) ([]T, string, error) {
+		items, next, err := fetch(ctx, cursor)
+		items = slices.DeleteFunc(items, func(item T) bool { return !keep(item) })
+		return items, next, err
+	}
+	return p
+}
+
+// Pages yields every page in turn. Iteration stops at the first error, which
+// is yielded with a nil page.
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		cursor := p.start
+		for page := 0; p.MaxPages <= 0 || page < p.MaxPages; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			items, next, err := p.fetch(ctx, cursor)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(items, nil) || next == "" {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// All yields every item of every page, honoring MaxPages and MaxItems.
+// Iteration stops at the first error, which is yielded with the zero T.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		count := 0
+		for items, err := range p.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if p.MaxItems > 0 && count >= p.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				count++
+			}
+			if p.MaxItems > 0 && count >= p.MaxItems {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers every item into a slice.
+func (p *Pager[T]) Collect(ctx context.Context) ([]T, error) {
+	ret := []T{}
+	for item, err := range p.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, item)
+	}
+	return ret, nil
+}
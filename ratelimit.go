@@ -0,0 +1,156 @@
+package opensea
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting how fast requests are sent. It is
+// safe for concurrent use, and one limiter may be shared by several clients
+// using the same API key so they draw from a single quota.
+type RateLimiter struct {
+	mu           sync.Mutex
+	rate         float64 // tokens added per second
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rps requests per second on
+// average and bursts of up to burst requests. OpenSea's default quota for a
+// single API key is around 4 requests per second.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimiter makes the client wait on limiter before every request,
+// retries included.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// SetRate changes the refill rate and burst size.
+func (l *RateLimiter) SetRate(rps float64, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(time.Now())
+	l.rate = rps
+	l.burst = float64(burst)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	_, err := l.wait(ctx)
+	return err
+}
+
+// wait is Wait that also reports whether the request had to wait at all.
+func (l *RateLimiter) wait(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	now := time.Now()
+	l.advance(now)
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		if l.rate > 0 {
+			wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		} else {
+			wait = time.Duration(1<<63 - 1)
+		}
+	}
+	if d := l.blockedUntil.Sub(now); d > wait {
+		wait = d
+	}
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		// Give the reserved token back, nothing was sent.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return wait > 0, err
+	}
+	return wait > 0, nil
+}
+
+// Update adapts the limiter to the rate-limit headers of a response. When
+// the server reports the quota as exhausted, or asks for a pause through
+// Retry-After, further requests wait until the reset time.
+func (l *RateLimiter) Update(h http.Header) {
+	now := time.Now()
+
+	var until time.Time
+	if d, ok := parseRetryAfter(h.Get("Retry-After"), now); ok {
+		until = now.Add(d)
+	}
+
+	remaining, err := strconv.ParseFloat(h.Get("X-RateLimit-Remaining"), 64)
+	hasRemaining := err == nil
+
+	if hasRemaining && remaining <= 0 {
+		if reset, ok := parseRateLimitReset(h.Get("X-RateLimit-Reset"), now); ok && reset.After(until) {
+			until = reset
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(now)
+	if hasRemaining && remaining < l.tokens {
+		l.tokens = remaining
+	}
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+// advance refills the bucket for the time elapsed since the last call.
+func (l *RateLimiter) advance(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+}
+
+// parseRateLimitReset reads X-RateLimit-Reset, which is sent either as the
+// number of seconds until the window resets or as a Unix timestamp.
+func parseRateLimitReset(v string, now time.Time) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	if n > 1e9 {
+		return time.Unix(int64(n), 0), true
+	}
+	return now.Add(time.Duration(n * float64(time.Second))), true
+}
@@ -0,0 +1,148 @@
+package opensea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Transport errors and
+// responses with one of RetryableStatusCodes are retried with exponential
+// backoff until MaxAttempts is reached or the request context is done.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles on every
+	// following attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including delays asked
+	// for by a Retry-After header. Zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0 to 1) of each delay that is randomized.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP statuses worth retrying.
+	RetryableStatusCodes []int
+	// OnRetry, if set, is called before sleeping ahead of another attempt.
+	OnRetry func(attempt int, delay time.Duration, err error)
+}
+
+// DefaultRetryPolicy returns a policy suited to OpenSea's rate limiting and
+// occasional gateway errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy enables retries for every endpoint of the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// RetryError is returned when a request still fails after being retried.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// retryable reports whether err, returned by a single attempt, is worth
+// another try.
+func (p RetryPolicy) retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	// Items of a streamed body may already have been handed to the caller.
+	var se *streamError
+	if errors.As(err, &se) {
+		return false
+	}
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return slices.Contains(p.RetryableStatusCodes, ae.StatusCode)
+	}
+	return true
+}
+
+// delay returns how long to wait before the attempt following attempt.
+func (p RetryPolicy) delay(attempt int, err error) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+
+	var ae *APIError
+	if errors.As(err, &ae) {
+		if ra, ok := parseRetryAfter(ae.Header.Get("Retry-After"), time.Now()); ok && ra > d {
+			d = ra
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds and an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,186 @@
+package opensea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// streamError wraps an error returned while consuming a streamed body. Such
+// a request is never retried, as part of the body may already have been
+// handed to the caller.
+type streamError struct {
+	err error
+}
+
+func (e *streamError) Error() string { return e.err.Error() }
+func (e *streamError) Unwrap() error { return e.err }
+
+// stream performs a GET request on behalf of op and passes the response body
+// to consume as it arrives instead of reading it into memory. Streamed
+// requests go through the middleware chain but bypass the cache and
+// coalescing.
+func (c *Client) stream(ctx context.Context, op, path string, consume func(io.Reader) error) error {
+	req := &Request{Operation: op, Method: http.MethodGet, Path: path, stream: consume}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		query, err := url.ParseQuery(path[i+1:])
+		if err != nil {
+			return fmt.Errorf("failed to parse query: %w", err)
+		}
+		req.Path, req.Query = path[:i], query
+	}
+
+	_, err := c.handler()(ctx, req)
+	return err
+}
+
+// decodeArray reads a JSON object from r and calls yield with each element of
+// its array member field, decoding one element at a time. It returns the
+// number of elements and the other members of the object, undecoded.
+func decodeArray[T any](r io.Reader, field string, yield func(*T) error) (int, map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return 0, nil, err
+	}
+
+	n := 0
+	rest := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return n, nil, err
+		}
+		key, _ := tok.(string)
+
+		if key != field {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return n, nil, err
+			}
+			rest[key] = raw
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return n, nil, err
+		}
+		if tok == nil {
+			continue
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return n, nil, fmt.Errorf("failed to decode %s: expected array, got %v", field, tok)
+		}
+		for dec.More() {
+			item := new(T)
+			if err := dec.Decode(item); err != nil {
+				return n, nil, fmt.Errorf("failed to decode %s[%d]: %w", field, n, err)
+			}
+			n++
+			if err := yield(item); err != nil {
+				return n, nil, err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return n, nil, err
+		}
+	}
+	return n, rest, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("failed to decode response: expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+// StreamNFTs is GetNFTs decoding the page one asset at a time: fn is called
+// with each asset as it is read from the response. It returns the cursor of
+// the next page. An error from fn stops the stream and is returned.
+func (c *Client) StreamNFTs(ctx context.Context, filter NFTFilter, fn func(*Asset) error) (next string, err error) {
+	err = c.stream(ctx, "GetNFTs", filter.path(), func(r io.Reader) error {
+		_, rest, err := decodeArray(r, "assets", func(a *Asset) error {
+			a.setChain(c.chain)
+			return fn(a)
+		})
+		if err != nil {
+			return err
+		}
+		if raw, ok := rest["next"]; ok {
+			return json.Unmarshal(raw, &next)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to stream NFTs: %w", err)
+	}
+	return next, nil
+}
+
+// StreamEvents is RetrievingEventsWithContext decoding events one at a time:
+// it pages through every event matching params, calling fn with each as it
+// is read. An error from fn stops the stream and is returned.
+func (c *Client) StreamEvents(ctx context.Context, params *RetrievingEventsParams, fn func(*Event) error) error {
+	if params == nil {
+		params = NewRetrievingEventsParams()
+	}
+	p := *params
+
+	for {
+		var n int
+		err := c.stream(ctx, "RetrievingEvents", eventsEP+"?"+p.Encode(), func(r io.Reader) (err error) {
+			n, _, err = decodeArray(r, "asset_events", func(e *Event) error {
+				e.setChain(c.chain)
+				if !p.matches(e) {
+					return nil
+				}
+				return fn(e)
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 || (p.Limit > 0 && n < p.Limit) {
+			return nil
+		}
+		p.Offset += n
+	}
+}
+
+// StreamOrders is GetOrdersWithContext decoding orders one at a time: it
+// pages through the orders of a contract listed after listedAfter, calling
+// fn with each as it is read. An error from fn stops the stream and is
+// returned.
+func (c *Client) StreamOrders(ctx context.Context, assetContractAddress string, listedAfter int64, fn func(*Order) error) error {
+	for offset := 0; ; {
+		var n int
+		path := ordersPath(assetContractAddress, listedAfter, offset, ordersPageSize)
+		err := c.stream(ctx, "GetOrders", path, func(r io.Reader) (err error) {
+			n, _, err = decodeArray(r, "orders", func(o *Order) error {
+				if o.Chain == "" {
+					o.Chain = c.chain
+				}
+				o.Asset.setChain(c.chain)
+				return fn(o)
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if n < ordersPageSize {
+			return nil
+		}
+		offset += n
+	}
+}
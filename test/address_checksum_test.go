@@ -0,0 +1,95 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+// Test vectors from EIP-55.
+var checksummed = []string{
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestParseAddress(t *testing.T) {
+	for _, s := range checksummed {
+		a, err := opensea.ParseAddress(s)
+		if err != nil {
+			t.Fatalf("ParseAddress(%q): %v", s, err)
+		}
+		if a.Checksum() != s {
+			t.Errorf("Checksum() = %q, want %q", a.Checksum(), s)
+		}
+		if string(a) != "0x"+lower(s[2:]) {
+			t.Errorf("ParseAddress(%q) = %q, want lowercase", s, a)
+		}
+	}
+
+	for _, s := range []string{
+		"",
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg",
+		"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", // bad checksum
+	} {
+		if _, err := opensea.ParseAddress(s); !errors.Is(err, opensea.ErrInvalidAddress) {
+			t.Errorf("ParseAddress(%q) error = %v, want ErrInvalidAddress", s, err)
+		}
+	}
+
+	// Single-case addresses carry no checksum.
+	if _, err := opensea.ParseAddress("0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddress_EqualAndJSON(t *testing.T) {
+	mixed := opensea.Address(checksummed[0])
+	low := opensea.MustParseAddress(checksummed[0])
+	if !mixed.Equal(low) || mixed.Equal(opensea.Address(checksummed[1])) {
+		t.Error("Equal does not compare hex addresses case-insensitively")
+	}
+	if opensea.Address("So1ana").Equal("so1ana") {
+		t.Error("non-hex addresses must match exactly")
+	}
+
+	var v struct {
+		A opensea.Address `json:"a"`
+		B opensea.Address `json:"b"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"`+checksummed[0]+`","b":null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.A != low || v.B != opensea.NullAddress {
+		t.Errorf("decoded %+v", v)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != `{"a":"`+string(low)+`","b":""}` {
+		t.Errorf("encoded %s", b)
+	}
+}
+
+func TestRetrievingEventsParams_SetAddressValidates(t *testing.T) {
+	p := opensea.NewRetrievingEventsParams()
+	if err := p.SetAssetContractAddress("0xnope"); !errors.Is(err, opensea.ErrInvalidAddress) {
+		t.Errorf("expected ErrInvalidAddress, got %v", err)
+	}
+	if err := p.SetAssetContractAddress(checksummed[0]); err != nil || !p.AssetContractAddress.Equal(opensea.Address(checksummed[0])) {
+		t.Errorf("got %q, %v", p.AssetContractAddress, err)
+	}
+}
+
+func lower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
@@ -8,8 +8,8 @@ import (
 )
 
 func TestParseAddress_Valid(t *testing.T) {
-	input := "0x123456789abcdef"
-	expected := opensea.Address("0x123456789abcdef")
+	input := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	expected := opensea.Address("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
 
 	address, err := opensea.ParseAddress(input)
 
@@ -22,9 +22,9 @@ func TestParseAddress_EmptyInput(t *testing.T) {
 
 	address, err := opensea.ParseAddress(input)
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, opensea.ErrInvalidAddress)
 	assert.Equal(t, opensea.NullAddress, address)
-	assert.Equal(t, "empty address", err.Error())
+	assert.Equal(t, "invalid address: empty address", err.Error())
 }
 
 func TestAddressString(t *testing.T) {
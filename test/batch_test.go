@@ -0,0 +1,145 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestClient_GetNFTsBatch(t *testing.T) {
+	const (
+		contractA opensea.Address = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+		contractB opensea.Address = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	)
+	var requests, requestsV2 atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/chain/base/contract/"+string(contractB)+"/nfts/7" {
+			requestsV2.Add(1)
+			fmt.Fprintf(w, `{"nft":{"identifier":"7","contract":%q}}`, contractB)
+			return
+		}
+		if r.URL.Path != "/api/v1/assets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests.Add(1)
+		q := r.URL.Query()
+		if q.Get("collection") != "" {
+			t.Errorf("unexpected collection param %q", q.Get("collection"))
+		}
+		contract := q.Get("asset_contract_address")
+
+		var assets []string
+		for _, id := range q["token_ids"] {
+			if id == "404" {
+				continue
+			}
+			assets = append(assets, fmt.Sprintf(`{"token_id":%q,"asset_contract":{"address":%q}}`, id, strings.ToUpper(contract)))
+		}
+		fmt.Fprintf(w, `{"assets":[%s]}`, strings.Join(assets, ","))
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	keys := []opensea.NFTKey{
+		{Contract: contractA, TokenID: opensea.TokenIDFromUint64(1)},
+		{Contract: contractA, TokenID: opensea.TokenIDFromUint64(2)},
+		{Contract: contractA, TokenID: opensea.TokenIDFromUint64(3)},
+		// Duplicates: exact, by contract case, and by naming the client's chain.
+		{Contract: contractA, TokenID: opensea.TokenIDFromUint64(1)},
+		{Contract: opensea.Address(strings.ToUpper(string(contractA))), TokenID: opensea.TokenIDFromUint64(2)},
+		{Chain: opensea.ChainEthereum, Contract: contractA, TokenID: opensea.TokenIDFromUint64(3)},
+		{Contract: contractA, TokenID: opensea.TokenIDFromUint64(404)},
+		{Chain: opensea.ChainBase, Contract: contractB, TokenID: opensea.TokenIDFromUint64(7)},
+		{Chain: "dogechain", Contract: contractB, TokenID: opensea.TokenIDFromUint64(8)},
+		{Contract: "", TokenID: opensea.TokenIDFromUint64(9)},
+	}
+
+	results := client.GetNFTsBatch(context.Background(), keys, opensea.BatchOptions{ChunkSize: 2, Concurrency: 2})
+
+	if len(results) != len(keys)-1 {
+		t.Errorf("got %d results, want %d", len(results), len(keys)-1)
+	}
+	// contractA has four distinct tokens in chunks of two; the Base token is
+	// not served by v1 and is fetched from v2 alone.
+	if got := requests.Load(); got != 2 {
+		t.Errorf("server saw %d v1 requests, want 2", got)
+	}
+	if got := requestsV2.Load(); got != 1 {
+		t.Errorf("server saw %d v2 requests, want 1", got)
+	}
+
+	for _, n := range []uint64{1, 2, 3} {
+		id := opensea.TokenIDFromUint64(n)
+		r := results[opensea.NFTKey{Contract: contractA, TokenID: id}]
+		if r.Err != nil || r.Asset == nil || r.Asset.TokenID != id {
+			t.Errorf("token %s: asset = %+v, err = %v", id, r.Asset, r.Err)
+		}
+	}
+	for _, k := range keys[4:6] {
+		if r := results[k]; r.Err != nil || r.Asset != results[opensea.NFTKey{Contract: contractA, TokenID: k.TokenID}].Asset {
+			t.Errorf("%s: asset = %+v, err = %v, want the result of its canonical key", k, r.Asset, r.Err)
+		}
+	}
+	if r := results[opensea.NFTKey{Contract: contractA, TokenID: opensea.TokenIDFromUint64(404)}]; !errors.Is(r.Err, opensea.ErrNotFound) {
+		t.Errorf("missing token: expected ErrNotFound, got %v", r.Err)
+	}
+	if r := results[opensea.NFTKey{Chain: opensea.ChainBase, Contract: contractB, TokenID: opensea.TokenIDFromUint64(7)}]; r.Err != nil || r.Asset != nil || r.NFT.Chain != opensea.ChainBase {
+		t.Errorf("base token: asset = %+v, nft = %+v, err = %v", r.Asset, r.NFT, r.Err)
+	}
+	if r := results[opensea.NFTKey{Chain: "dogechain", Contract: contractB, TokenID: opensea.TokenIDFromUint64(8)}]; !errors.Is(r.Err, opensea.ErrUnsupportedChain) {
+		t.Errorf("expected ErrUnsupportedChain, got %v", r.Err)
+	}
+	if r := results[opensea.NFTKey{TokenID: opensea.TokenIDFromUint64(9)}]; !errors.Is(r.Err, opensea.ErrEmptyContractAddress) {
+		t.Errorf("expected ErrEmptyContractAddress, got %v", r.Err)
+	}
+}
+
+func TestClient_GetContractsBatch(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		addr := strings.TrimPrefix(r.URL.Path, "/api/v1/asset_contract/")
+		if addr == "0xdead" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"address":%q}`, addr)
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	const (
+		lower opensea.Address = "0xcccccccccccccccccccccccccccccccccccccccc"
+		upper opensea.Address = "0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC"
+	)
+	results := client.GetContractsBatch(context.Background(),
+		[]opensea.Address{"0xaaa", "0xbbb", "0xaaa", "0xdead", lower, upper}, opensea.BatchOptions{})
+
+	if got := requests.Load(); got != 4 {
+		t.Errorf("server saw %d requests, want 4", got)
+	}
+	if len(results) != 5 {
+		t.Errorf("got %d results, want 5", len(results))
+	}
+	for _, a := range []opensea.Address{lower, upper} {
+		if r := results[a]; r.Err != nil || r.Contract.Address != lower {
+			t.Errorf("%s: contract = %+v, err = %v", a, r.Contract, r.Err)
+		}
+	}
+	for _, a := range []opensea.Address{"0xaaa", "0xbbb"} {
+		if r := results[a]; r.Err != nil || r.Contract.Address != a {
+			t.Errorf("%s: contract = %+v, err = %v", a, r.Contract, r.Err)
+		}
+	}
+	if r := results["0xdead"]; !errors.Is(r.Err, opensea.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", r.Err)
+	}
+}
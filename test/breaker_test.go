@@ -0,0 +1,162 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	healthy := false
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	var transitions []string
+	breaker := opensea.NewCircuitBreaker(opensea.BreakerConfig{
+		FailureThreshold: 3,
+		Cooldown:         50 * time.Millisecond,
+		OnStateChange: func(from, to opensea.CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+		},
+	})
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithCircuitBreaker(breaker))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetPath(ctx, "/x"); !errors.Is(err, opensea.ErrServerError) {
+			t.Fatalf("call %d: expected ErrServerError, got %v", i, err)
+		}
+	}
+	if breaker.State() != opensea.CircuitOpen {
+		t.Fatalf("state = %s, want open", breaker.State())
+	}
+
+	if _, err := client.GetPath(ctx, "/x"); !errors.Is(err, opensea.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("open circuit reached the server: %d calls", calls)
+	}
+
+	// A failed probe opens the circuit again.
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.GetPath(ctx, "/x"); !errors.Is(err, opensea.ErrServerError) {
+		t.Fatalf("expected probe to fail with ErrServerError, got %v", err)
+	}
+	if breaker.State() != opensea.CircuitOpen {
+		t.Fatalf("state = %s, want open", breaker.State())
+	}
+
+	// A successful probe closes it.
+	healthy = true
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.GetPath(ctx, "/x"); err != nil {
+		t.Fatal(err)
+	}
+	if breaker.State() != opensea.CircuitClosed {
+		t.Fatalf("state = %s, want closed", breaker.State())
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if fmt.Sprint(transitions) != fmt.Sprint(want) {
+		t.Errorf("transitions = %v, want %v", transitions, want)
+	}
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotTrip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	breaker := opensea.NewCircuitBreaker(opensea.BreakerConfig{FailureThreshold: 1})
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithCircuitBreaker(breaker))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetPath(context.Background(), "/x"); !errors.Is(err, opensea.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	}
+	if breaker.State() != opensea.CircuitClosed {
+		t.Errorf("state = %s, want closed", breaker.State())
+	}
+}
+
+func TestCircuitBreaker_IgnoresStaleResults(t *testing.T) {
+	arrived := make(chan string, 2)
+	release := map[string]chan struct{}{"/slow": make(chan struct{}), "/probe": make(chan struct{})}
+	finished := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		arrived <- r.URL.Path
+		select {
+		case <-release[r.URL.Path]:
+		case <-finished:
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	// Unblock the handlers if the test fails early, so Close returns.
+	defer close(finished)
+
+	breaker := opensea.NewCircuitBreaker(opensea.BreakerConfig{FailureThreshold: 1, Cooldown: 20 * time.Millisecond})
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithCircuitBreaker(breaker))
+	ctx := context.Background()
+
+	get := func(path string) chan error {
+		errc := make(chan error, 1)
+		go func() {
+			_, err := client.GetPath(ctx, path)
+			errc <- err
+		}()
+		return errc
+	}
+
+	// A slow request is sent while closed, then the circuit trips.
+	slow := get("/slow")
+	<-arrived
+	if _, err := client.GetPath(ctx, "/fail"); !errors.Is(err, opensea.ErrServerError) {
+		t.Fatalf("expected ErrServerError, got %v", err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	probe := get("/probe")
+	<-arrived
+
+	// The slow request succeeds while the probe is still out. It must not
+	// close the circuit or free the probe slot.
+	close(release["/slow"])
+	if err := <-slow; err != nil {
+		t.Fatal(err)
+	}
+	if breaker.State() != opensea.CircuitHalfOpen {
+		t.Fatalf("state = %s after a stale success, want half-open", breaker.State())
+	}
+	if _, err := client.GetPath(ctx, "/fail"); !errors.Is(err, opensea.ErrCircuitOpen) {
+		t.Fatalf("second probe let through: %v", err)
+	}
+
+	close(release["/probe"])
+	if err := <-probe; err != nil {
+		t.Fatal(err)
+	}
+	if breaker.State() != opensea.CircuitClosed {
+		t.Fatalf("state = %s after the probe succeeded, want closed", breaker.State())
+	}
+}
@@ -0,0 +1,129 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestBytesJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`"0xdeadBEEF"`, "0xdeadbeef"},
+		{`"deadbeef"`, "0xdeadbeef"},
+		{`"0XAB"`, "0xab"},
+		{`"0x"`, "0x"},
+		{`""`, ""},
+	}
+	for _, tt := range tests {
+		var b opensea.Bytes
+		if err := json.Unmarshal([]byte(tt.in), &b); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", tt.in, err)
+		}
+		if tt.want != "" && b.Hex() != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, b.Hex(), tt.want)
+		}
+	}
+
+	for _, in := range []string{`"0xabc"`, `"0xzz"`, `12`, `"0x0Xab"`, `"0X0xab"`} {
+		var b opensea.Bytes
+		if err := json.Unmarshal([]byte(in), &b); !errors.Is(err, opensea.ErrInvalidBytes) {
+			t.Errorf("Unmarshal(%s) error = %v, want ErrInvalidBytes", in, err)
+		}
+	}
+
+	out, err := json.Marshal(struct {
+		A opensea.Bytes `json:"a"`
+		B opensea.Bytes `json:"b"`
+	}{A: opensea.Bytes{0x01, 0xab}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"a":"0x01ab","b":null}` {
+		t.Errorf("Marshal = %s", out)
+	}
+	if s := fmt.Sprintf("%s %v", opensea.Bytes{0x01, 0xab}, opensea.Bytes(nil)); s != "0x01ab 0x" {
+		t.Errorf("formatted = %q", s)
+	}
+}
+
+func TestBytesBSON(t *testing.T) {
+	want := opensea.Bytes{0xde, 0xad}
+	typ, data, err := want.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got opensea.Bytes
+	if err := got.UnmarshalBSONValue(typ, data); err != nil {
+		t.Fatal(err)
+	}
+	if got.Hex() != "0xdead" {
+		t.Errorf("round trip = %s, want 0xdead", got.Hex())
+	}
+}
+
+func TestBytesABI(t *testing.T) {
+	// transferFrom(0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed, 0x0, 42)
+	calldata, err := opensea.ParseBytes("0x23b872dd" +
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"000000000000000000000000000000000000000000000000000000000000002a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sel, err := calldata.Selector()
+	if err != nil || sel.Hex() != "0x23b872dd" {
+		t.Errorf("Selector = %s, %v", sel.Hex(), err)
+	}
+	args, err := calldata.Args()
+	if err != nil {
+		t.Fatal(err)
+	}
+	words, err := args.Words()
+	if err != nil || len(words) != 3 {
+		t.Fatalf("Words = %d, %v", len(words), err)
+	}
+	if from, err := args.WordAddress(0); err != nil || from != "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" {
+		t.Errorf("WordAddress(0) = %s, %v", from, err)
+	}
+	if id, err := args.WordBig(2); err != nil || id.Int64() != 42 {
+		t.Errorf("WordBig(2) = %v, %v", id, err)
+	}
+	if _, err := args.Word(3); !errors.Is(err, opensea.ErrBytesLength) {
+		t.Errorf("Word(3) error = %v, want ErrBytesLength", err)
+	}
+	if _, err := calldata[:10].Args(); !errors.Is(err, opensea.ErrBytesLength) {
+		t.Errorf("Args on unaligned calldata error = %v, want ErrBytesLength", err)
+	}
+}
+
+func TestOrderValidateSignature(t *testing.T) {
+	var o opensea.Order
+	err := json.Unmarshal([]byte(`{
+		"v": 27,
+		"r": "0x`+strings.Repeat("11", 32)+`",
+		"s": "`+strings.Repeat("22", 32)+`"
+	}`), &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.ValidateSignature(); err != nil {
+		t.Errorf("ValidateSignature: %v", err)
+	}
+
+	short := opensea.Bytes{0x01}
+	o.S = &short
+	if err := o.ValidateSignature(); !errors.Is(err, opensea.ErrBytesLength) {
+		t.Errorf("ValidateSignature error = %v, want ErrBytesLength", err)
+	}
+	if err := (opensea.Order{}).ValidateSignature(); err == nil {
+		t.Error("ValidateSignature accepted an unsigned order")
+	}
+}
@@ -0,0 +1,177 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestLRUCache_Evicts(t *testing.T) {
+	c := opensea.NewLRUCache(2)
+	c.Set("a", &opensea.CacheEntry{Body: []byte("a")})
+	c.Set("b", &opensea.CacheEntry{Body: []byte("b")})
+	c.Get("a")
+	c.Set("c", &opensea.CacheEntry{Body: []byte("c")})
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("expected a to survive")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len = %d, want 2", c.Len())
+	}
+}
+
+func TestClient_CacheHitsAndBypass(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"name":"Cached"}`))
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key",
+		opensea.WithCache(opensea.NewLRUCache(16), opensea.DefaultCacheConfig()))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetContract(ctx, "0xabc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("server calls = %d, want 1", calls)
+	}
+	if stats := client.CacheStats(); stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("stats = %+v, want 2 hits and 1 miss", stats)
+	}
+
+	if _, err := client.GetContract(opensea.BypassCache(ctx), "0xabc"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("BypassCache did not reach the server")
+	}
+
+	// Events are not cached by the default configuration.
+	client.GetPath(ctx, "/api/v1/events/?limit=1")
+	client.GetPath(ctx, "/api/v1/events/?limit=1")
+	if calls != 4 {
+		t.Errorf("server calls = %d, want 4", calls)
+	}
+}
+
+func TestClient_CacheRevalidatesWithETag(t *testing.T) {
+	var calls, notModified int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name":"Tagged"}`))
+	}))
+	defer srv.Close()
+
+	config := opensea.CacheConfig{DefaultTTL: time.Millisecond}
+	metrics := opensea.NewPrometheusMetrics()
+	client := opensea.NewClient(srv.URL, "test-api-key",
+		opensea.WithCache(opensea.NewLRUCache(16), config), opensea.WithMetrics(metrics))
+	ctx := context.Background()
+
+	if _, err := client.GetContract(ctx, "0xabc"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	contract, err := client.GetContract(ctx, "0xabc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contract.Name != "Tagged" {
+		t.Errorf("Name = %q, want Tagged", contract.Name)
+	}
+	if notModified != 1 {
+		t.Errorf("expected one conditional request, got %d", notModified)
+	}
+	// The revalidated lookup is a hit in both the stats and the metrics.
+	if stats := client.CacheStats(); stats != (opensea.CacheStats{Hits: 1, Misses: 1, Revalidations: 1}) {
+		t.Errorf("stats = %+v, want 1 hit, 1 miss and 1 revalidation", stats)
+	}
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	for _, want := range []string{
+		`opensea_cache_hits_total{operation="GetContract"} 1`,
+		`opensea_cache_misses_total{operation="GetContract"} 1`,
+	} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("missing %q in:\n%s", want, rec.Body)
+		}
+	}
+}
+
+func TestClient_CallerIfNoneMatchIsNotRevalidation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`{"name":"Fresh"}`))
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key",
+		opensea.WithCache(opensea.NewLRUCache(16), opensea.DefaultCacheConfig()),
+		opensea.WithMiddleware(opensea.HeaderMiddleware(http.Header{"If-None-Match": {`"v1"`}})))
+	ctx := context.Background()
+
+	// A 304 is only a success when the cache sent the ETag and has the body;
+	// here neither the cached contract path nor the uncached events path do.
+	for _, get := range []func() error{
+		func() error { _, err := client.GetContract(ctx, "0xabc"); return err },
+		func() error { _, err := client.GetPath(ctx, "/api/v1/events/?limit=1"); return err },
+	} {
+		var apiErr *opensea.APIError
+		if err := get(); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotModified {
+			t.Errorf("expected a 304 *APIError, got %v", err)
+		}
+	}
+	if stats := client.CacheStats(); stats != (opensea.CacheStats{Misses: 1}) {
+		t.Errorf("stats = %+v, want 1 miss", stats)
+	}
+}
+
+func TestDiskCache_Persists(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := opensea.NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.Set("key", &opensea.CacheEntry{Body: []byte(`{}`), ETag: "x", Expires: time.Now().Add(time.Hour)})
+
+	second, err := opensea.NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, ok := second.Get("key")
+	if !ok || entry.ETag != "x" || string(entry.Body) != `{}` {
+		t.Fatalf("entry not persisted: %+v", entry)
+	}
+
+	second.Delete("key")
+	if _, ok := first.Get("key"); ok {
+		t.Error("expected entry to be deleted")
+	}
+}
@@ -0,0 +1,102 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestParseChain(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    opensea.Chain
+		wantErr bool
+	}{
+		{"ethereum", opensea.ChainEthereum, false},
+		{"Polygon", opensea.ChainPolygon, false},
+		{"matic", opensea.ChainPolygon, false},
+		{" base ", opensea.ChainBase, false},
+		{"avax", opensea.ChainAvalanche, false},
+		{"sepolia", opensea.ChainSepolia, false},
+		{"rinkeby", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := opensea.ParseChain(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseChain(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err != nil && !errors.Is(err, opensea.ErrUnsupportedChain) {
+			t.Errorf("ParseChain(%q) error %v is not ErrUnsupportedChain", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseChain(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if !opensea.ChainSepolia.IsTestnet() || opensea.ChainEthereum.IsTestnet() {
+		t.Error("IsTestnet misclassifies chains")
+	}
+}
+
+func TestClient_ChainThreading(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/v2/chain/base/contract/0xabc/nfts/1":
+			w.Write([]byte(`{"nft":{"identifier":"1"}}`))
+		case "/api/v2/chain/matic/account/0xowner/nfts":
+			w.Write([]byte(`{"nfts":[{"identifier":"1"},{"identifier":"2"}]}`))
+		case "/api/v1/asset/0xabc/1":
+			w.Write([]byte(`{"token_id":"1","asset_contract":{"address":"0xabc"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithChain(opensea.ChainPolygon))
+	ctx := context.Background()
+
+	nft, err := client.GetNFTV2(ctx, opensea.ChainBase, "0xabc", opensea.TokenIDFromUint64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nft.Chain != opensea.ChainBase {
+		t.Errorf("NFT.Chain = %q, want base", nft.Chain)
+	}
+
+	// An empty chain falls back to the client's chain.
+	page, err := client.ListNFTsByAccount(ctx, "", "0xowner", opensea.ListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range page.NFTs {
+		if n.Chain != opensea.ChainPolygon {
+			t.Errorf("NFT.Chain = %q, want matic", n.Chain)
+		}
+	}
+
+	asset, err := client.GetNFT(ctx, "0xabc", opensea.TokenIDFromUint64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Chain != opensea.ChainPolygon || asset.AssetContract.Chain != opensea.ChainPolygon {
+		t.Errorf("asset chain = %q, contract chain = %q", asset.Chain, asset.AssetContract.Chain)
+	}
+
+	calls := len(paths)
+	if _, err := client.GetNFTV2(ctx, "dogechain", "0xabc", opensea.TokenIDFromUint64(1)); !errors.Is(err, opensea.ErrUnsupportedChain) {
+		t.Errorf("expected ErrUnsupportedChain, got %v", err)
+	}
+	if len(paths) != calls {
+		t.Error("an invalid chain reached the server")
+	}
+}
@@ -0,0 +1,78 @@
+package opensea_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-KEY"); got != "override-key" {
+			t.Errorf("X-API-KEY = %q, want %q", got, "override-key")
+		}
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q, want application/json", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "indexer/1.0" {
+			t.Errorf("User-Agent = %q, want %q", got, "indexer/1.0")
+		}
+
+		switch r.URL.Path {
+		case "/api/v1/asset_contract/0xabc":
+			json.NewEncoder(w).Encode(map[string]any{"name": "Test Contract"})
+		case "/api/v1/events/":
+			json.NewEncoder(w).Encode(map[string]any{"asset_events": []any{}})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	hc := &http.Client{}
+	client := opensea.NewClient("", "test-api-key",
+		opensea.WithBaseURL(srv.URL),
+		opensea.WithAPIKey("override-key"),
+		opensea.WithHTTPClient(hc),
+		opensea.WithUserAgent("indexer/1.0"),
+		opensea.WithTimeout(time.Second),
+	)
+
+	contract, err := client.GetContract(context.Background(), "0xabc")
+	if err != nil {
+		t.Fatalf("GetContract failed: %v", err)
+	}
+	if contract.Name != "Test Contract" {
+		t.Errorf("Name = %q, want %q", contract.Name, "Test Contract")
+	}
+
+	events, err := client.RetrievingEvents(opensea.NewRetrievingEventsParams())
+	if err != nil {
+		t.Fatalf("RetrievingEvents failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events, got %d", len(events))
+	}
+
+	if hc.Timeout != 0 {
+		t.Errorf("WithTimeout mutated the supplied http.Client")
+	}
+}
+
+func TestClient_NonOKStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>bad gateway</html>"))
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	if _, err := client.GetPath(context.Background(), "/anything"); err == nil {
+		t.Fatal("expected an error for a non-200 response")
+	}
+}
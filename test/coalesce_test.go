@@ -0,0 +1,81 @@
+package opensea_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestClient_Coalescing(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Write([]byte(`{"address":"0xabc","name":"Contract"}`))
+	}))
+	defer srv.Close()
+
+	metrics := opensea.NewPrometheusMetrics()
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithCoalescing(), opensea.WithMetrics(metrics))
+	ctx := context.Background()
+
+	const n = 5
+	contracts := make([]*opensea.AssetContract, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			contracts[i], errs[i] = client.GetContract(ctx, "0xabc")
+		}()
+	}
+
+	// An opted-out call always goes upstream on its own.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := client.GetContract(opensea.NoCoalesce(ctx), "0xabc"); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// Give the leader and the opted-out call time to reach the server and
+	// the followers time to join the leader's call.
+	for calls.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server saw %d calls, want 2", got)
+	}
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if contracts[i].Name != "Contract" {
+			t.Errorf("contract %d = %+v", i, contracts[i])
+		}
+	}
+	contracts[0].Name = "changed"
+	if contracts[1].Name != "Contract" {
+		t.Error("callers share a decoded result")
+	}
+
+	var b strings.Builder
+	metrics.WriteTo(&b)
+	if !strings.Contains(b.String(), `opensea_coalesced_total{operation="GetContract"} 4`) {
+		t.Errorf("coalesced calls not counted:\n%s", b.String())
+	}
+}
@@ -0,0 +1,193 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"12", "12"},
+		{"-0.015", "-0.015"},
+		{"1.50", "1.5"},
+		{"1.5e18", "1500000000000000000"},
+		{"123456789012345678901234567890.000000000000000001", "123456789012345678901234567890.000000000000000001"},
+		{"2E-3", "0.002"},
+	}
+	for _, tt := range tests {
+		d, err := opensea.ParseDecimal(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q): %v", tt.in, err)
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "abc", "1.2.3", "1e", "--1", "0x10", "1e-1001", "1e1001", "1e-2000000000"} {
+		if _, err := opensea.ParseDecimal(in); !errors.Is(err, opensea.ErrInvalidDecimal) {
+			t.Errorf("ParseDecimal(%q) error = %v, want ErrInvalidDecimal", in, err)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := opensea.MustParseDecimal("1.25")
+	b := opensea.MustParseDecimal("0.5")
+
+	if got := a.Add(b).String(); got != "1.75" {
+		t.Errorf("Add = %s", got)
+	}
+	if got := b.Sub(a).String(); got != "-0.75" {
+		t.Errorf("Sub = %s", got)
+	}
+	if got, err := a.Mul(b); err != nil || got.String() != "0.625" {
+		t.Errorf("Mul = %s, %v", got, err)
+	}
+	q, err := a.Quo(opensea.DecimalFromInt(3), 4)
+	if err != nil || q.String() != "0.4167" {
+		t.Errorf("Quo = %s, %v", q, err)
+	}
+	if _, err := a.Quo(opensea.Decimal{}, 2); !errors.Is(err, opensea.ErrDivisionByZero) {
+		t.Errorf("Quo by zero error = %v", err)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || !b.Equal(opensea.MustParseDecimal("0.500")) {
+		t.Error("Cmp/Equal ignore scale incorrectly")
+	}
+	if got := opensea.MustParseDecimal("-2.5").Round(0).String(); got != "-3" {
+		t.Errorf("Round(-2.5) = %s, want -3", got)
+	}
+}
+
+func TestDecimalScaleOverflow(t *testing.T) {
+	maxScale := opensea.NewDecimal(big.NewInt(1), math.MaxInt32)
+	minScale := opensea.NewDecimal(big.NewInt(1), math.MinInt32)
+	for name, f := range map[string]func() (opensea.Decimal, error){
+		"Mul max":        func() (opensea.Decimal, error) { return maxScale.Mul(maxScale) },
+		"Mul min":        func() (opensea.Decimal, error) { return minScale.Mul(minScale) },
+		"Shift max":      func() (opensea.Decimal, error) { return maxScale.Shift(-1) },
+		"Shift min":      func() (opensea.Decimal, error) { return minScale.Shift(1) },
+		"Shift MinInt32": func() (opensea.Decimal, error) { return opensea.DecimalFromInt(1).Shift(math.MinInt32) },
+	} {
+		if _, err := f(); !errors.Is(err, opensea.ErrDecimalRange) {
+			t.Errorf("%s: error = %v, want ErrDecimalRange", name, err)
+		}
+	}
+
+	// Right at the edge the result is still exact.
+	d, err := maxScale.Shift(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err = d.Shift(-1); err != nil || !d.Equal(maxScale) {
+		t.Errorf("Shift round trip = %v, %v", d, err)
+	}
+	if _, err := opensea.FromWei(maxScale, 1); !errors.Is(err, opensea.ErrDecimalRange) {
+		t.Errorf("FromWei error = %v, want ErrDecimalRange", err)
+	}
+}
+
+func TestWeiConversion(t *testing.T) {
+	token := opensea.PaymentToken{Symbol: "WETH", Decimals: 18, UsdPrice: opensea.MustParseDecimal("3000.5")}
+
+	units, err := token.Units("1234500000000000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := units.String(); got != "1.234500000000000001" {
+		t.Errorf("Units = %s", got)
+	}
+	wei, err := token.Wei(units)
+	if err != nil || wei.String() != "1234500000000000001" {
+		t.Errorf("Wei = %v, %v", wei, err)
+	}
+	if _, err := token.Wei(opensea.MustParseDecimal("0.0000000000000000001")); err == nil {
+		t.Error("Wei accepted an amount finer than the token's precision")
+	}
+	if _, err := token.Units("1.2.3"); !errors.Is(err, opensea.ErrInvalidDecimal) {
+		t.Errorf("Units error = %v, want ErrInvalidDecimal", err)
+	}
+
+	usd, err := token.USD("2000000000000000000")
+	if err != nil || opensea.FormatUSD(usd) != "$6,001.00" {
+		t.Errorf("USD = %s, %v", usd, err)
+	}
+
+	e := opensea.Event{TotalPrice: "1500000000000000000", PaymentToken: &token}
+	if p, err := e.Price(); err != nil || opensea.FormatETH(p) != "1.5 ETH" {
+		t.Errorf("Event.Price = %s, %v", p, err)
+	}
+	o := opensea.Order{CurrentPrice: "250000000000000000.0"}
+	if p, err := o.Price(18); err != nil || p.String() != "0.25" {
+		t.Errorf("Order.Price = %s, %v", p, err)
+	}
+
+	// Decimals beyond int32 used to wrap around: 1<<32 + 18 became 18.
+	if _, err := o.Price(1<<32 + 18); err == nil {
+		t.Error("Order.Price accepted out-of-range decimals")
+	}
+	if _, err := opensea.ToWei(units, -1); err == nil {
+		t.Error("ToWei accepted negative decimals")
+	}
+}
+
+func TestFormatUSD(t *testing.T) {
+	tests := map[string]string{
+		"0":           "$0.00",
+		"1234.5":      "$1,234.50",
+		"-0.249":      "-$0.25",
+		"1000000.005": "$1,000,000.01",
+	}
+	for in, want := range tests {
+		if got := opensea.FormatUSD(opensea.MustParseDecimal(in)); got != want {
+			t.Errorf("FormatUSD(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		A opensea.Decimal `json:"a"`
+		B opensea.Decimal `json:"b"`
+		C opensea.Decimal `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"0.1","b":3000.123456789012345678,"c":null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.A.String() != "0.1" || v.B.String() != "3000.123456789012345678" || !v.C.IsZero() {
+		t.Errorf("decoded %s %s %s", v.A, v.B, v.C)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"a":"0.1","b":"3000.123456789012345678","c":"0"}` {
+		t.Errorf("Marshal = %s", b)
+	}
+	if err := json.Unmarshal([]byte(`{"a":"x"}`), &v); !errors.Is(err, opensea.ErrInvalidDecimal) {
+		t.Errorf("Unmarshal error = %v, want ErrInvalidDecimal", err)
+	}
+}
+
+func TestDecimalBSON(t *testing.T) {
+	d := opensea.NewDecimal(big.NewInt(-12345), 3)
+	typ, data, err := d.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got opensea.Decimal
+	if err := got.UnmarshalBSONValue(typ, data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(d) || got.String() != "-12.345" {
+		t.Errorf("round trip = %s, want -12.345", got)
+	}
+}
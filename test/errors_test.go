@@ -0,0 +1,82 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestAPIError_Taxonomy(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		sentinel error
+		messages []string
+	}{
+		{"v2 errors array", http.StatusNotFound, `{"errors":["NFT not found"]}`, opensea.ErrNotFound, []string{"NFT not found"}},
+		{"v1 detail", http.StatusUnauthorized, `{"detail":"Invalid API key"}`, opensea.ErrUnauthorized, []string{"Invalid API key"}},
+		{"rate limited", http.StatusTooManyRequests, `{"detail":"Request was throttled."}`, opensea.ErrRateLimited, []string{"Request was throttled."}},
+		{"html error page", http.StatusBadGateway, `<html>bad gateway</html>`, opensea.ErrServerError, nil},
+		{"bad request", http.StatusBadRequest, `{"errors":[{"message":"limit too large"}]}`, opensea.ErrBadRequest, []string{"limit too large"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			client := opensea.NewClient(srv.URL, "test-api-key")
+			_, err := client.GetNFT(context.Background(), "0x123", opensea.TokenIDFromUint64(1))
+
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+
+			var apiErr *opensea.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Endpoint != "/api/v1/asset/0x123/1" {
+				t.Errorf("Endpoint = %q", apiErr.Endpoint)
+			}
+			if string(apiErr.Body) != tt.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.body)
+			}
+			if strings.Join(apiErr.Messages, "|") != strings.Join(tt.messages, "|") {
+				t.Errorf("Messages = %v, want %v", apiErr.Messages, tt.messages)
+			}
+			if !strings.Contains(err.Error(), http.StatusText(tt.status)) {
+				t.Errorf("error %q does not mention the status", err)
+			}
+		})
+	}
+}
+
+func TestAPIError_SurvivesRetries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(fastRetryPolicy()))
+	_, err := client.GetContract(context.Background(), "0xabc")
+
+	if !errors.Is(err, opensea.ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if errors.Is(err, opensea.ErrNotFound) {
+		t.Errorf("a 429 must not match ErrNotFound")
+	}
+}
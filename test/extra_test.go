@@ -0,0 +1,148 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestModelsKeepUnknownFields(t *testing.T) {
+	in := `{"id":1,"token_id":"7","new_field":{"a":[1,2]},"collection":{"name":"Punks","shiny":true},"traits":[{"trait_type":"Hat","value":"Cap","rarity":0.1}]}`
+
+	var a opensea.Asset
+	if err := json.Unmarshal([]byte(in), &a); err != nil {
+		t.Fatal(err)
+	}
+	if string(a.Extra["new_field"]) != `{"a":[1,2]}` || len(a.Extra) != 1 {
+		t.Errorf("Asset.Extra = %v", a.Extra)
+	}
+	if string(a.Collection.Extra["shiny"]) != "true" {
+		t.Errorf("Collection.Extra = %v", a.Collection.Extra)
+	}
+	if string(a.Traits[0].Extra["rarity"]) != "0.1" {
+		t.Errorf("Trait.Extra = %v", a.Traits[0].Extra)
+	}
+
+	out, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again opensea.Asset
+	if err := json.Unmarshal(out, &again); err != nil {
+		t.Fatal(err)
+	}
+	if string(again.Extra["new_field"]) != `{"a":[1,2]}` || string(again.Collection.Extra["shiny"]) != "true" ||
+		string(again.Traits[0].Extra["rarity"]) != "0.1" {
+		t.Errorf("unknown fields lost in round trip: %s", out)
+	}
+}
+
+func TestMarshalExtraDoesNotOverrideFields(t *testing.T) {
+	o := opensea.Order{ID: 3, Extra: map[string]json.RawMessage{"id": json.RawMessage("99"), "z": json.RawMessage(`"x"`)}}
+	out, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(out, &m); err != nil {
+		t.Fatal(err)
+	}
+	if string(m["id"]) != "3" || string(m["z"]) != `"x"` {
+		t.Errorf("Marshal = %s", out)
+	}
+
+	var decoded opensea.Order
+	if err := json.Unmarshal([]byte(`{"extra":"5","unknown":1}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ExtraPrice != "5" || string(decoded.Extra["unknown"]) != "1" || len(decoded.Extra) != 1 {
+		t.Errorf("ExtraPrice = %q, Extra = %v", decoded.ExtraPrice, decoded.Extra)
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	var owner opensea.NFTOwner
+	if err := opensea.UnmarshalStrict([]byte(`{"address":"0xabc","quantity":1}`), &owner); err != nil {
+		t.Errorf("exact document: %v", err)
+	}
+
+	var page opensea.ListingsPage
+	err := opensea.UnmarshalStrict([]byte(`{"listings":[{"order_hash":"0x1","chain":"ethereum","surprise":1,"price":{"current":{"currency":"ETH","decimals":18,"value":"1"}}}],"next":""}`), &page)
+	var drift *opensea.SchemaDrift
+	if !errors.As(err, &drift) {
+		t.Fatalf("expected *SchemaDrift, got %v", err)
+	}
+	if !slices.Equal(drift.Unknown, []string{"listings[].surprise"}) {
+		t.Errorf("Unknown = %v", drift.Unknown)
+	}
+	if !slices.Contains(drift.Missing, "listings[].protocol_data") || slices.Contains(drift.Missing, "next") {
+		t.Errorf("Missing = %v", drift.Missing)
+	}
+	if len(page.Listings) != 1 || page.Listings[0].OrderHash != "0x1" {
+		t.Errorf("document not decoded: %+v", page)
+	}
+}
+
+func TestListingRoundTrip(t *testing.T) {
+	in := `{"order_hash":"0x1","chain":"ethereum","type":"basic","protocol_address":"0x00000000000000adc04c56bf30ac9d3c0aaf14dc","new":1,` +
+		`"price":{"current":{"currency":"ETH","decimals":18,"value":"1000"}},` +
+		`"protocol_data":{"signature":"0xsig","parameters":{"offerer":"0x0000000000000000000000000000000000000001",` +
+		`"offer":[{"itemType":2,"token":"0x0000000000000000000000000000000000000abc","identifierOrCriteria":"7","startAmount":"1","endAmount":"1","foo":"bar"}],` +
+		`"consideration":[{"itemType":0,"token":"0x0000000000000000000000000000000000000000","identifierOrCriteria":"0","startAmount":"975","endAmount":"975","recipient":"0x0000000000000000000000000000000000000001","foo":"bar"},` +
+		`{"itemType":0,"token":"0x0000000000000000000000000000000000000000","identifierOrCriteria":"0","startAmount":"25","endAmount":"25","recipient":"0x0000000000000000000000000000000000000002"}],` +
+		`"startTime":"1","endTime":"2","orderType":0,"zone":"0x0000000000000000000000000000000000000000","zoneHash":"0x0","salt":"0x5","conduitKey":"0x0","totalOriginalConsiderationItems":2,"counter":0}}}`
+
+	var l opensea.Listing
+	if err := json.Unmarshal([]byte(in), &l); err != nil {
+		t.Fatal(err)
+	}
+	c := l.ProtocolData.Parameters.Consideration
+	if len(c) != 2 || c[0].Recipient != "0x0000000000000000000000000000000000000001" || c[0].StartAmount != "975" {
+		t.Fatalf("Consideration = %+v", c)
+	}
+	if string(c[0].Extra["foo"]) != `"bar"` || len(c[0].Extra) != 1 || c[0].OfferItem.Extra != nil {
+		t.Errorf("Consideration[0].Extra = %v, OfferItem.Extra = %v", c[0].Extra, c[0].OfferItem.Extra)
+	}
+
+	out, err := json.Marshal(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(out), `"foo"`); n != 2 {
+		t.Errorf("foo written %d times, want 2: %s", n, out)
+	}
+	var want, got any
+	if err := json.Unmarshal([]byte(in), &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip changed the document:\n got %s\nwant %s", out, in)
+	}
+}
+
+// Every field of OfferItem must be decoded on a ConsiderationItem too, so a
+// field added to OfferItem cannot end up in ConsiderationItem.Extra.
+func TestConsiderationItemKeepsOfferItemFields(t *testing.T) {
+	typ := reflect.TypeFor[opensea.OfferItem]()
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name == "-" || name == "" {
+			continue
+		}
+		var c opensea.ConsiderationItem
+		if err := json.Unmarshal([]byte(`{"`+name+`":null,"recipient":"0x1"}`), &c); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, ok := c.Extra[name]; ok || c.Recipient != "0x1" {
+			t.Errorf("%s: Extra = %v, Recipient = %q", name, c.Extra, c.Recipient)
+		}
+	}
+}
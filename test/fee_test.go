@@ -0,0 +1,116 @@
+package opensea_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestBasisPoints(t *testing.T) {
+	b := opensea.BasisPoints(250)
+	if b.Percent().String() != "2.5" || b.Fraction().String() != "0.025" || b.String() != "2.5%" {
+		t.Errorf("Percent = %s, Fraction = %s, String = %s", b.Percent(), b.Fraction(), b)
+	}
+	fee, err := b.Apply("1000000000000000000")
+	if err != nil || fee.String() != "25000000000000000" {
+		t.Errorf("Apply = %s, %v", fee, err)
+	}
+	if _, err := b.Apply("nope"); err == nil {
+		t.Error("Apply accepted a malformed price")
+	}
+}
+
+func TestBasisPointsJSON(t *testing.T) {
+	var c opensea.Collection
+	err := json.Unmarshal([]byte(`{"dev_seller_fee_basis_points": "500", "opensea_seller_fee_basis_points": 250, "dev_buyer_fee_basis_points": null}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.DevSellerFeeBasisPoints != 500 || c.OpenseaSellerFeeBasisPoints != 250 || c.DevBuyerFeeBasisPoints != 0 {
+		t.Errorf("decoded %+v", c)
+	}
+
+	var a opensea.AssetContract
+	if err := json.Unmarshal([]byte(`{"dev_seller_fee_basis_points": 100, "opensea_seller_fee_basis_points": "250.0"}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.OpenseaSellerFeeBasisPoints != 250 {
+		t.Errorf("OpenseaSellerFeeBasisPoints = %d, want 250", a.OpenseaSellerFeeBasisPoints)
+	}
+
+	var b opensea.BasisPoints
+	if err := json.Unmarshal([]byte(`"2.5"`), &b); err == nil {
+		t.Error("accepted fractional basis points")
+	}
+
+	typ, data, err := opensea.BasisPoints(750).MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.UnmarshalBSONValue(typ, data); err != nil || b != 750 {
+		t.Errorf("BSON round trip = %d, %v", b, err)
+	}
+}
+
+func TestFeeSchedule(t *testing.T) {
+	asset := opensea.Asset{
+		Collection:    &opensea.Collection{DevSellerFeeBasisPoints: 500, OpenseaSellerFeeBasisPoints: 250},
+		AssetContract: &opensea.NFTContract{DevSellerFeeBasisPoints: 100},
+	}
+	fees := asset.FeeSchedule()
+	if fees.Creator != 500 || fees.OpenSea != 250 || fees.Total != 750 {
+		t.Errorf("FeeSchedule = %+v", fees)
+	}
+	proceeds, err := fees.Proceeds("2000")
+	if err != nil || proceeds.String() != "1850" {
+		t.Errorf("Proceeds = %s, %v", proceeds, err)
+	}
+
+	asset.Collection = nil
+	if fees := asset.FeeSchedule(); fees.Creator != 100 || fees.Total != 100 {
+		t.Errorf("contract FeeSchedule = %+v", fees)
+	}
+	if fees := (opensea.Asset{}).FeeSchedule(); fees != (opensea.FeeSchedule{}) {
+		t.Errorf("empty FeeSchedule = %+v", fees)
+	}
+}
+
+func TestCollectionDetailsFeeSchedule(t *testing.T) {
+	const doc = `{"collection":"punks","fees":[` +
+		`{"fee":2.5,"recipient":"0x0000A26B00C1F0DF003000390027140000FAA719","required":true},` +
+		`{"fee":0.1,"recipient":"0xcreator","required":true},` +
+		`{"fee":4.9,"recipient":"0xcreator2","required":false}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/collections/punks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(doc))
+	}))
+	defer srv.Close()
+
+	var details opensea.CollectionDetails
+	if err := json.Unmarshal([]byte(doc), &details); err != nil {
+		t.Fatal(err)
+	}
+	if bp := details.Fees[1].BasisPoints(); bp != 10 {
+		t.Errorf("BasisPoints of 0.1%% = %d, want 10", bp)
+	}
+	want := opensea.FeeSchedule{Creator: 500, OpenSea: 250, Total: 750}
+	if fees := details.FeeSchedule(); fees != want {
+		t.Errorf("FeeSchedule = %+v, want %+v", fees, want)
+	}
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	fees, err := client.GetNFTFeeSchedule(context.Background(), &opensea.NFT{Collection: "punks"})
+	if err != nil || fees != want {
+		t.Errorf("GetNFTFeeSchedule = %+v, %v", fees, err)
+	}
+	if _, err := client.GetNFTFeeSchedule(context.Background(), &opensea.NFT{}); err == nil {
+		t.Error("GetNFTFeeSchedule accepted an NFT without a collection")
+	}
+}
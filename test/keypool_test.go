@@ -0,0 +1,95 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestKeyPool_RoundRobinAndCooldown(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-API-KEY")
+		mu.Lock()
+		seen = append(seen, key)
+		mu.Unlock()
+		if key == "bad" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	pool := opensea.NewKeyPool([]string{"a", "bad", "c", "a"}, opensea.RoundRobin, time.Hour)
+	client := opensea.NewClient(srv.URL, "", opensea.WithKeyPool(pool))
+	ctx := context.Background()
+
+	var errs int
+	for i := 0; i < 6; i++ {
+		if _, err := client.GetPath(ctx, "/x"); errors.Is(err, opensea.ErrUnauthorized) {
+			errs++
+		}
+	}
+
+	want := []string{"a", "bad", "c", "a", "c", "a"}
+	if len(seen) != len(want) {
+		t.Fatalf("keys sent = %v, want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("keys sent = %v, want %v", seen, want)
+		}
+	}
+	if errs != 1 {
+		t.Errorf("got %d unauthorized errors, want 1", errs)
+	}
+
+	stats := pool.Stats()
+	if len(stats) != 3 {
+		t.Fatalf("got %d keys, want 3", len(stats))
+	}
+	if stats[0].Requests != 3 || stats[1].Requests != 1 || stats[2].Requests != 2 {
+		t.Errorf("unexpected request counts %+v", stats)
+	}
+	if stats[1].Unauthorized != 1 || !stats[1].CooldownUntil.After(time.Now()) {
+		t.Errorf("bad key not on cool-down: %+v", stats[1])
+	}
+}
+
+func TestKeyPool_LeastUsedRetriesOnAnotherKey(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-API-KEY")
+		seen = append(seen, key)
+		if key == "a" {
+			w.Header().Set("Retry-After", "7200")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	pool := opensea.NewKeyPool([]string{"a", "b"}, opensea.LeastUsed, time.Minute)
+	client := opensea.NewClient(srv.URL, "", opensea.WithKeyPool(pool), opensea.WithRetryPolicy(fastRetryPolicy()))
+
+	if _, err := client.GetPath(context.Background(), "/x"); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 2 || seen[0] != "a" || seen[1] != "b" {
+		t.Errorf("keys sent = %v, want [a b]", seen)
+	}
+
+	a := pool.Stats()[0]
+	if a.RateLimited != 1 || time.Until(a.CooldownUntil) < time.Hour {
+		t.Errorf("Retry-After not applied to cool-down: %+v", a)
+	}
+}
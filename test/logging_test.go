@@ -0,0 +1,71 @@
+package opensea_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestClient_Logger(t *testing.T) {
+	const key = "secret-api-key"
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// Response headers that echo credentials must be redacted too.
+		w.Header().Set("X-Echo", "key="+key)
+		w.Header().Set("Authorization", "Bearer session-token")
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"detail":"bad key ` + key + `"}`))
+			return
+		}
+		w.Write([]byte(`{"asset_events":[],"next":"abc"}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := opensea.NewClient(srv.URL, key, opensea.WithLogger(logger), opensea.WithRetryPolicy(fastRetryPolicy()))
+
+	if _, err := client.ListEventsByCollection(context.Background(), "doodles", opensea.EventsParams{Next: "cur1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(buf.String(), key) || strings.Contains(buf.String(), "session-token") {
+		t.Fatalf("credentials leaked into logs:\n%s", buf.String())
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+
+	failed, ok := records[0], records[1]
+	if failed["level"] != "WARN" || failed["status"] != float64(503) || failed["attempt"] != float64(1) {
+		t.Errorf("unexpected failed record %v", failed)
+	}
+	if ok["level"] != "INFO" || ok["status"] != float64(200) || ok["attempt"] != float64(2) {
+		t.Errorf("unexpected record %v", ok)
+	}
+	if ok["operation"] != "ListEventsByCollection" || ok["method"] != "GET" ||
+		ok["path"] != "/api/v2/events/collection/doodles" || ok["cursor"] != "cur1" {
+		t.Errorf("unexpected record %v", ok)
+	}
+	if !strings.Contains(ok["response_body"].(string), `"next":"abc"`) {
+		t.Errorf("response body not dumped at debug level: %v", ok)
+	}
+}
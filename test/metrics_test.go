@@ -0,0 +1,96 @@
+package opensea_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	var calls int
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"address":"0xabc"}`))
+	}))
+	defer api.Close()
+
+	metrics := opensea.NewPrometheusMetrics()
+	client := opensea.NewClient(api.URL, "test-api-key",
+		opensea.WithMetrics(metrics),
+		opensea.WithRetryPolicy(fastRetryPolicy()),
+		opensea.WithCache(opensea.NewLRUCache(10), opensea.DefaultCacheConfig()),
+		opensea.WithRateLimiter(opensea.NewRateLimiter(1000, 10)),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetContract(ctx, "0xabc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scrape := httptest.NewServer(metrics)
+	defer scrape.Close()
+	resp, err := http.Get(scrape.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	out := string(b)
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	for _, want := range []string{
+		"# TYPE opensea_requests_total counter",
+		`opensea_requests_total{operation="GetContract",status="200"} 1`,
+		`opensea_requests_total{operation="GetContract",status="502"} 1`,
+		`opensea_request_errors_total{status="502"} 1`,
+		`opensea_request_duration_seconds_bucket{operation="GetContract",le="+Inf"} 2`,
+		`opensea_request_duration_seconds_count{operation="GetContract"} 2`,
+		`opensea_retries_total{operation="GetContract"} 1`,
+		`opensea_cache_hits_total{operation="GetContract"} 1`,
+		`opensea_cache_misses_total{operation="GetContract"} 1`,
+		// The burst of 10 lets both requests through without waiting.
+		"opensea_rate_limit_waits_total 0",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestPrometheusMetrics_CountsOnlyRateLimitWaits(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+
+	metrics := opensea.NewPrometheusMetrics()
+	client := opensea.NewClient(api.URL, "test-api-key",
+		opensea.WithMetrics(metrics),
+		opensea.WithRateLimiter(opensea.NewRateLimiter(20, 1)),
+	)
+	// The first request takes the only token; the next two wait for refills.
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetPath(context.Background(), "/x"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if want := "opensea_rate_limit_waits_total 2\n"; !strings.Contains(rec.Body.String(), want) {
+		t.Errorf("missing %q in:\n%s", want, rec.Body)
+	}
+}
@@ -0,0 +1,74 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		if strings.HasPrefix(r.URL.Path, "/api/v2/collection/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":["not found"]}`))
+			return
+		}
+		w.Write([]byte(`{"nfts":[]}`))
+	}))
+	defer srv.Close()
+
+	var order []string
+	trace := func(name string) opensea.Middleware {
+		return func(next opensea.Handler) opensea.Handler {
+			return func(ctx context.Context, req *opensea.Request) (*opensea.Response, error) {
+				order = append(order, name)
+				return next(ctx, req)
+			}
+		}
+	}
+
+	var calls []opensea.CallInfo
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithMiddleware(
+		trace("outer"),
+		opensea.LoggingMiddleware(func(info opensea.CallInfo) { calls = append(calls, info) }),
+		opensea.HeaderMiddleware(http.Header{"x-request-id": {"abc"}, "X-API-KEY": {"override"}}),
+		trace("inner"),
+	))
+	ctx := context.Background()
+
+	if _, err := client.ListNFTsByCollection(ctx, "doodles", opensea.ListParams{Limit: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(order, ","); got != "outer,inner" {
+		t.Errorf("middleware order = %s", got)
+	}
+	if gotHeader.Get("X-Request-Id") != "abc" || gotHeader.Get("X-API-KEY") != "override" {
+		t.Errorf("injected headers not sent: %v", gotHeader)
+	}
+
+	_, err := client.ListNFTsByCollection(ctx, "missing", opensea.ListParams{})
+	if !errors.Is(err, opensea.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if len(calls) != 2 {
+		t.Fatalf("logged %d calls, want 2", len(calls))
+	}
+	ok := calls[0]
+	if ok.Operation != "ListNFTsByCollection" || ok.Path != "/api/v2/collection/doodles/nfts" ||
+		ok.Query.Get("limit") != "5" || ok.StatusCode != http.StatusOK || ok.Err != nil || ok.Latency <= 0 {
+		t.Errorf("unexpected call info %+v", ok)
+	}
+	failed := calls[1]
+	var apiErr *opensea.APIError
+	if failed.StatusCode != http.StatusNotFound || !errors.As(failed.Err, &apiErr) {
+		t.Errorf("unexpected call info %+v", failed)
+	}
+}
@@ -27,7 +27,7 @@ func TestGetMusic(t *testing.T) {
 			Assets: []Asset{
 				{
 					ID:           1,
-					TokenID:      "123",
+					TokenID:      TokenIDFromUint64(123),
 					Name:         "Test Music NFT",
 					AnimationURL: "https://example.com/music.mp3",
 				},
@@ -83,7 +83,7 @@ func TestGetTrendingMusic(t *testing.T) {
 			Assets: []Asset{
 				{
 					ID:           1,
-					TokenID:      "123",
+					TokenID:      TokenIDFromUint64(123),
 					Name:         "Trending Music NFT",
 					AnimationURL: "https://example.com/trending.mp3",
 					NumSales:     100,
@@ -169,7 +169,7 @@ func TestMusicFilters(t *testing.T) {
 		{
 			name: "Token IDs Filter",
 			filter: MusicFilter{
-				TokenIDs: []string{"1", "2"},
+				TokenIDs: []TokenID{TokenIDFromUint64(1), TokenIDFromUint64(2)},
 				Limit:    20,
 			},
 			want: "token_ids=1&token_ids=2",
@@ -0,0 +1,88 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestNetworkRegistry(t *testing.T) {
+	for _, name := range []opensea.Network{opensea.Mainnet, opensea.Sepolia, opensea.Amoy, opensea.Rinkeby} {
+		info, err := opensea.LookupNetwork(name)
+		if err != nil {
+			t.Fatalf("LookupNetwork(%s): %v", name, err)
+		}
+		if info.APIURL == "" || info.Chain == "" || info.PermalinkHost == "" {
+			t.Errorf("incomplete network %+v", info)
+		}
+		if info.Testnet != (name != opensea.Mainnet) {
+			t.Errorf("%s: Testnet = %v", name, info.Testnet)
+		}
+	}
+
+	if _, err := opensea.LookupNetwork("nope"); !errors.Is(err, opensea.ErrUnknownNetwork) {
+		t.Errorf("expected ErrUnknownNetwork, got %v", err)
+	}
+	if _, err := opensea.NewNetworkClient("nope", "key"); !errors.Is(err, opensea.ErrUnknownNetwork) {
+		t.Errorf("expected ErrUnknownNetwork, got %v", err)
+	}
+
+	// A misspelled network must not silently fall back to mainnet.
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "key", opensea.WithNetwork("sepolia-test"), opensea.WithBaseURL(srv.URL))
+	if _, err := client.GetCollection(context.Background(), "punks"); !errors.Is(err, opensea.ErrUnknownNetwork) || called {
+		t.Errorf("request on an unknown network: err = %v, reached server = %v", err, called)
+	}
+
+	if err := opensea.RegisterNetwork(opensea.NetworkInfo{Name: "bad", APIURL: "not a url"}); err == nil {
+		t.Error("expected an error for an invalid API URL")
+	}
+
+	sepolia, _ := opensea.LookupNetwork(opensea.Sepolia)
+	want := "https://testnets.opensea.io/assets/sepolia/0xabc/1"
+	if got := sepolia.Permalink("", "0xabc", opensea.TokenIDFromUint64(1)); got != want {
+		t.Errorf("Permalink = %q, want %q", got, want)
+	}
+}
+
+func TestRegisterNetwork_LocalMock(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"nft":{"identifier":"1"}}`))
+	}))
+	defer srv.Close()
+
+	err := opensea.RegisterNetwork(opensea.NetworkInfo{
+		Name:          "mock",
+		APIURL:        srv.URL + "/",
+		Chain:         opensea.ChainBase,
+		PermalinkHost: "http://mock.local",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := opensea.NewNetworkClient("mock", "test-api-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nft, err := client.GetNFTV2(context.Background(), "", "0xabc", opensea.TokenIDFromUint64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/api/v2/chain/base/contract/0xabc/nfts/1" || nft.Chain != opensea.ChainBase {
+		t.Errorf("requested %s, chain %s", path, nft.Chain)
+	}
+	if got := client.Permalink("", "0xabc", opensea.TokenIDFromUint64(1)); got != "http://mock.local/assets/base/0xabc/1" {
+		t.Errorf("Permalink = %q", got)
+	}
+}
@@ -40,28 +40,28 @@ func TestGetNFT(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Successful NFT retrieval", func(t *testing.T) {
-		asset, err := client.GetNFT(ctx, "0x123", "1")
+		asset, err := client.GetNFT(ctx, "0x123", opensea.TokenIDFromUint64(1))
 		require.NoError(t, err)
 		assert.Equal(t, "SampleNFT", asset.Name)
-		assert.Equal(t, "1", asset.TokenID)
+		assert.Equal(t, "1", asset.TokenID.String())
 	})
 
 	t.Run("Empty contract address", func(t *testing.T) {
-		asset, err := client.GetNFT(ctx, "", "1")
+		asset, err := client.GetNFT(ctx, "", opensea.TokenIDFromUint64(1))
 		assert.Error(t, err)
 		assert.Nil(t, asset)
 		assert.EqualError(t, err, "contract address cannot be empty")
 	})
 
 	t.Run("Empty token ID", func(t *testing.T) {
-		asset, err := client.GetNFT(ctx, "0x123", "")
+		asset, err := client.GetNFT(ctx, "0x123", opensea.TokenID{})
 		assert.Error(t, err)
 		assert.Nil(t, asset)
 		assert.EqualError(t, err, "token ID cannot be empty")
 	})
 
 	t.Run("NFT not found", func(t *testing.T) {
-		asset, err := client.GetNFT(ctx, "0x123", "2")
+		asset, err := client.GetNFT(ctx, "0x123", opensea.TokenIDFromUint64(2))
 		assert.Error(t, err)
 		assert.Nil(t, asset)
 		assert.EqualError(t, err, "failed to get NFT: NFT not found")
@@ -69,7 +69,7 @@ func TestGetNFT(t *testing.T) {
 
 	t.Run("Invalid JSON response", func(t *testing.T) {
 		mockClient.Responses["/assetEP/0x123/3"] = []byte(`invalid json`)
-		asset, err := client.GetNFT(ctx, "0x123", "3")
+		asset, err := client.GetNFT(ctx, "0x123", opensea.TokenIDFromUint64(3))
 		assert.Error(t, err)
 		assert.Nil(t, asset)
 		assert.Contains(t, err.Error(), "failed to unmarshal NFT")
@@ -53,7 +53,7 @@ func TestClient_GetAsset(t *testing.T) {
 			tokenID:        "123",
 			mockResponse: &opensea.Asset{
 				ID:          "1",
-				TokenID:     "123",
+				TokenID:     opensea.TokenIDFromUint64(123),
 				Name:        "Test NFT",
 				Description: "Test NFT Description",
 				Collection: opensea.Collection{
@@ -103,12 +103,12 @@ func TestClient_GetAssets(t *testing.T) {
 		Assets: []opensea.Asset{
 			{
 				ID:      "1",
-				TokenID: "123",
+				TokenID: opensea.TokenIDFromUint64(123),
 				Name:    "Test NFT 1",
 			},
 			{
 				ID:      "2",
-				TokenID: "124",
+				TokenID: opensea.TokenIDFromUint64(124),
 				Name:    "Test NFT 2",
 			},
 		},
@@ -2,7 +2,6 @@ package opensea
 
 import (
 	"github.com/cheekybits/is"
-	"math/big"
 	"os"
 	"testing"
 )
@@ -31,7 +30,7 @@ func TestGetSingleAsset(t *testing.T) {
 	assert := is.New(t)
 
 	contractAddress := "0xb47e3cd837ddf8e4c57f05d70ab865de6e193bbb"
-	tokenID := big.NewInt(1)
+	tokenID := TokenIDFromUint64(1)
 
 	singleAsset, err := openseaClient.GetSingleAsset(contractAddress, tokenID)
 	assert.Nil(err)
@@ -0,0 +1,132 @@
+package opensea_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestOffsetPager_Limits(t *testing.T) {
+	fetches := 0
+	pager := opensea.NewOffsetPager(0, 3, func(ctx context.Context, offset, limit int) ([]int, error) {
+		fetches++
+		var page []int
+		for i := offset; i < offset+limit && i < 10; i++ {
+			page = append(page, i)
+		}
+		return page, nil
+	})
+
+	all, err := pager.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 10 || fetches != 4 {
+		t.Errorf("got %d items in %d fetches, want 10 in 4", len(all), fetches)
+	}
+
+	fetches = 0
+	pager.MaxItems = 5
+	if got, _ := pager.Collect(context.Background()); len(got) != 5 || fetches != 2 {
+		t.Errorf("MaxItems: got %d items in %d fetches, want 5 in 2", len(got), fetches)
+	}
+
+	fetches = 0
+	pager.MaxItems = 0
+	pager.MaxPages = 1
+	if got, _ := pager.Collect(context.Background()); len(got) != 3 || fetches != 1 {
+		t.Errorf("MaxPages: got %d items in %d fetches, want 3 in 1", len(got), fetches)
+	}
+}
+
+func TestPager_StopsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	pager := opensea.NewCursorPager("", func(ctx context.Context, cursor string) ([]string, string, error) {
+		if cursor == "" {
+			return []string{"a"}, "next", nil
+		}
+		return nil, "", boom
+	})
+
+	var items []string
+	var gotErr error
+	for item, err := range pager.All(context.Background()) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		items = append(items, item)
+	}
+	if len(items) != 1 || !errors.Is(gotErr, boom) {
+		t.Errorf("items = %v, err = %v", items, gotErr)
+	}
+}
+
+func TestClient_NFTsPager(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		resp := map[string]any{
+			"assets": []map[string]any{{"token_id": fmt.Sprint(page)}},
+		}
+		if page < 2 {
+			resp["next"] = fmt.Sprint(page + 1)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+
+	var ids []string
+	for asset, err := range client.NFTsPager(opensea.NFTFilter{Collection: "test"}).All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, asset.TokenID.String())
+	}
+	if fmt.Sprint(ids) != "[0 1 2]" {
+		t.Errorf("token ids = %v, want [0 1 2]", ids)
+	}
+}
+
+func TestClient_EventsPagerFiltersWithoutStopping(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var events []map[string]any
+		if offset == 0 {
+			events = []map[string]any{
+				{"id": 1, "asset": map[string]any{"asset_contract": map[string]any{"address": "0xwanted"}}},
+				{"id": 2, "asset": map[string]any{"asset_contract": map[string]any{"address": "0xother"}}},
+			}
+		} else if offset == 2 {
+			events = []map[string]any{
+				{"id": 3, "asset": map[string]any{"asset_contract": map[string]any{"address": "0xwanted"}}},
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"asset_events": events})
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	params := opensea.NewRetrievingEventsParams()
+	params.AssetContractAddress = "0xwanted"
+	params.Limit = 2
+
+	events, err := client.RetrievingEvents(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].ID != 1 || events[1].ID != 3 {
+		t.Errorf("unexpected events %+v", events)
+	}
+	if params.Offset != 0 {
+		t.Errorf("params were mutated, Offset = %d", params.Offset)
+	}
+}
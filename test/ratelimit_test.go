@@ -0,0 +1,68 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestRateLimiter_SharedAcrossClients(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	limiter := opensea.NewRateLimiter(50, 1)
+	a := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRateLimiter(limiter))
+	b := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRateLimiter(limiter))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := a.GetPath(context.Background(), "/a"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.GetPath(context.Background(), "/b"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Six requests at 50/s with a burst of one need at least 100ms.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("requests were not rate limited, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	limiter := opensea.NewRateLimiter(0.1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRateLimiter_UpdateFromHeaders(t *testing.T) {
+	limiter := opensea.NewRateLimiter(1000, 10)
+
+	h := http.Header{}
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Reset", "1")
+	limiter.Update(h)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the limiter to block until the reset, got %v", err)
+	}
+}
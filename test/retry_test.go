@@ -0,0 +1,113 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func fastRetryPolicy() opensea.RetryPolicy {
+	p := opensea.DefaultRetryPolicy()
+	p.BaseDelay = time.Millisecond
+	p.MaxDelay = 10 * time.Millisecond
+	return p
+}
+
+func TestRetry_RecoversFromTransientStatus(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"name":"ok"}`))
+	}))
+	defer srv.Close()
+
+	var retries []int
+	policy := fastRetryPolicy()
+	policy.OnRetry = func(attempt int, delay time.Duration, err error) {
+		retries = append(retries, attempt)
+	}
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(policy))
+
+	contract, err := client.GetContract(context.Background(), "0xabc")
+	if err != nil {
+		t.Fatalf("GetContract failed: %v", err)
+	}
+	if contract.Name != "ok" {
+		t.Errorf("Name = %q, want ok", contract.Name)
+	}
+	if len(retries) != 2 || retries[0] != 1 || retries[1] != 2 {
+		t.Errorf("OnRetry attempts = %v, want [1 2]", retries)
+	}
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := client.GetPath(context.Background(), "/api/v1/events/")
+	var re *opensea.RetryError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected *RetryError, got %v", err)
+	}
+	if re.Attempts != 4 || atomic.LoadInt32(&calls) != 4 {
+		t.Errorf("attempts = %d, calls = %d, want 4", re.Attempts, calls)
+	}
+}
+
+func TestRetry_SkipsNonRetryableStatus(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(fastRetryPolicy()))
+
+	if _, err := client.GetPath(context.Background(), "/missing"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRetry_StopsOnContextCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxDelay = time.Minute
+	client := opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetPath(ctx, "/slow")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("retry loop ignored context cancellation")
+	}
+}
@@ -0,0 +1,202 @@
+package opensea_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+// eventsPage returns an events page of n events, each carrying an asset
+// with a collection as the v1 API does.
+func eventsPage(offset, n int) []byte {
+	events := make([]string, n)
+	for i := range events {
+		id := offset + i
+		events[i] = fmt.Sprintf(`{"id":%d,"event_type":"successful","total_price":"1000",`+
+			`"asset":{"token_id":"%d","name":"Token %d","description":%q,`+
+			`"asset_contract":{"address":"0xabc","name":"Contract"},`+
+			`"collection":{"name":"Collection","slug":"collection","description":%q}}}`,
+			id, id, id, strings.Repeat("d", 512), strings.Repeat("c", 2048))
+	}
+	return []byte(`{"asset_events":[` + strings.Join(events, ",") + `]}`)
+}
+
+// eventsServer serves total events. Pages are built once so the server
+// does not weigh on the benchmarks.
+func eventsServer(total int) *httptest.Server {
+	var pages sync.Map
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		key := [2]int{offset, limit}
+		page, ok := pages.Load(key)
+		if !ok {
+			page, _ = pages.LoadOrStore(key, eventsPage(offset, max(0, min(limit, total-offset))))
+		}
+		w.Write(page.([]byte))
+	}))
+}
+
+func TestClient_StreamEvents(t *testing.T) {
+	srv := eventsServer(250)
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "test-api-key")
+
+	params := opensea.NewRetrievingEventsParams()
+	var ids []uint64
+	err := client.StreamEvents(context.Background(), params, func(e *opensea.Event) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 250 || ids[0] != 0 || ids[249] != 249 {
+		t.Errorf("streamed %d events", len(ids))
+	}
+
+	// An error from the callback stops the stream and is not retried.
+	stop := errors.New("stop")
+	var seen int
+	client = opensea.NewClient(srv.URL, "test-api-key", opensea.WithRetryPolicy(fastRetryPolicy()))
+	err = client.StreamEvents(context.Background(), params, func(e *opensea.Event) error {
+		seen++
+		if seen == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || seen != 3 {
+		t.Errorf("got err %v after %d events", err, seen)
+	}
+}
+
+func TestClient_StreamNFTs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"next":"cur2","assets":[{"token_id":"1"},{"token_id":"2"}],"previous":null}`))
+	}))
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "test-api-key")
+
+	var ids []string
+	next, err := client.StreamNFTs(context.Background(), opensea.NFTFilter{Collection: "c"}, func(a *opensea.Asset) error {
+		ids = append(ids, a.TokenID.String())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != "cur2" || len(ids) != 2 || ids[1] != "2" {
+		t.Errorf("next = %q, ids = %v", next, ids)
+	}
+}
+
+func TestClient_StreamOrders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			w.Write([]byte(`{"count":0,"orders":[]}`))
+			return
+		}
+		orders := make([]string, 100)
+		for i := range orders {
+			orders[i] = fmt.Sprintf(`{"id":%d}`, i)
+		}
+		fmt.Fprintf(w, `{"count":100,"orders":[%s]}`, strings.Join(orders, ","))
+	}))
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "test-api-key")
+
+	var n int
+	err := client.StreamOrders(context.Background(), "0xabc", 0, func(o *opensea.Order) error {
+		n++
+		return nil
+	})
+	if err != nil || n != 100 {
+		t.Errorf("streamed %d orders, err = %v", n, err)
+	}
+}
+
+// BenchmarkClient_RetrievingEvents and BenchmarkClient_StreamEvents fetch the
+// same 2000-event page, about 5 MB. Besides B/op they report peak-heap-B,
+// the highest heap in use above the level before the loop; streaming keeps
+// it lower by never holding the body and the decoded page at once.
+
+// reportPeakHeap runs the benchmark loop f while sampling the heap in use,
+// and reports the high-water mark above the starting level as peak-heap-B.
+// The collector runs often meanwhile so the samples follow live memory
+// rather than garbage awaiting collection.
+func reportPeakHeap(b *testing.B, f func()) {
+	f() // warm up the server's page cache and the client's connection
+	defer debug.SetGCPercent(debug.SetGCPercent(5))
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	base, peak := ms.HeapInuse, ms.HeapInuse
+
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		var ms runtime.MemStats
+		for {
+			runtime.ReadMemStats(&ms)
+			peak = max(peak, ms.HeapInuse)
+			select {
+			case <-stop:
+				return
+			case <-time.After(100 * time.Microsecond):
+			}
+		}
+	}()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+	b.StopTimer()
+	close(stop)
+	<-stopped
+	b.ReportMetric(float64(peak-base), "peak-heap-B")
+}
+
+func BenchmarkClient_RetrievingEvents(b *testing.B) {
+	srv := eventsServer(2000)
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	params := opensea.NewRetrievingEventsParams()
+	params.Limit = 2000
+
+	reportPeakHeap(b, func() {
+		if _, err := client.RetrievingEventsWithContext(context.Background(), params); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+func BenchmarkClient_StreamEvents(b *testing.B) {
+	srv := eventsServer(2000)
+	defer srv.Close()
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	params := opensea.NewRetrievingEventsParams()
+	params.Limit = 2000
+
+	reportPeakHeap(b, func() {
+		err := client.StreamEvents(context.Background(), params, func(e *opensea.Event) error {
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	})
+}
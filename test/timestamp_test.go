@@ -0,0 +1,128 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2021-08-01T12:00:00.123456", time.Date(2021, 8, 1, 12, 0, 0, 123456000, time.UTC)},
+		{"2021-08-01T12:00:00", time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)},
+		{"2021-08-01T14:00:00+02:00", time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)},
+		{"2021-08-01T12:00:00.5Z", time.Date(2021, 8, 1, 12, 0, 0, 500000000, time.UTC)},
+		{"2021-08-01", time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"20210801", time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"20210801T120000Z", time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)},
+		{"1627819200", time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)},
+		{"1627819200123", time.Date(2021, 8, 1, 12, 0, 0, 123000000, time.UTC)},
+		{"1627819200.25", time.Date(2021, 8, 1, 12, 0, 0, 250000000, time.UTC)},
+	}
+	for _, tt := range tests {
+		ts, err := opensea.ParseTimestamp(tt.in)
+		if err != nil {
+			t.Fatalf("ParseTimestamp(%q): %v", tt.in, err)
+		}
+		if !ts.Time().Equal(tt.want) {
+			t.Errorf("ParseTimestamp(%q) = %v, want %v", tt.in, ts.Time(), tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "0"} {
+		if ts, err := opensea.ParseTimestamp(in); err != nil || !ts.IsZero() {
+			t.Errorf("ParseTimestamp(%q) = %v, %v, want zero", in, ts, err)
+		}
+	}
+	if _, err := opensea.ParseTimestamp("yesterday"); !errors.Is(err, opensea.ErrInvalidTimestamp) {
+		t.Errorf("ParseTimestamp error = %v, want ErrInvalidTimestamp", err)
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		A opensea.Timestamp `json:"a"`
+		B opensea.Timestamp `json:"b"`
+		C opensea.Timestamp `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"2021-08-01T12:00:00.123456","b":1627819200,"c":null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.A.Unix() != 1627819200 || v.B.Unix() != 1627819200 || !v.C.IsZero() {
+		t.Errorf("decoded %v %v %v", v.A, v.B, v.C)
+	}
+
+	// A JSON number is always a Unix time, while the same digits as a
+	// string are a compact ISO date.
+	var digits struct {
+		Str opensea.Timestamp `json:"str"`
+		Num opensea.Timestamp `json:"num"`
+	}
+	if err := json.Unmarshal([]byte(`{"str":"20210801","num":20210801}`), &digits); err != nil {
+		t.Fatal(err)
+	}
+	if digits.Str.Unix() != 1627776000 || digits.Num.Unix() != 20210801 {
+		t.Errorf("decoded %v %v", digits.Str, digits.Num)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"a":"2021-08-01T12:00:00.123456Z","b":"2021-08-01T12:00:00Z","c":null}` {
+		t.Errorf("Marshal = %s", b)
+	}
+}
+
+func TestTimestampBSON(t *testing.T) {
+	want := opensea.NewTimestamp(time.Date(2021, 8, 1, 12, 0, 0, 123456000, time.UTC))
+	typ, data, err := want.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got opensea.Timestamp
+	if err := got.UnmarshalBSONValue(typ, data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Time().Equal(want.Time()) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+}
+
+func TestEventTimestamps(t *testing.T) {
+	var e opensea.Event
+	err := json.Unmarshal([]byte(`{
+		"created_date": "2021-08-01T12:00:00.123456",
+		"event_timestamp": "2021-08-01T12:00:00",
+		"transaction": {"timestamp": "2021-08-01T12:00:00"}
+	}`), &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.CreatedDate.Unix() != 1627819200 || e.EventTimestamp.Unix() != 1627819200 || e.Transaction.Timestamp.Unix() != 1627819200 {
+		t.Errorf("decoded %v %v %v", e.CreatedDate, e.EventTimestamp, e.Transaction.Timestamp)
+	}
+}
+
+func TestOrderAndNFTTimestamps(t *testing.T) {
+	var o opensea.Order
+	if err := json.Unmarshal([]byte(`{"expiration_time":1700000000,"listing_time":"1690000000"}`), &o); err != nil {
+		t.Fatal(err)
+	}
+	if o.ExpirationTime.Unix() != 1700000000 || o.ListingTime.Unix() != 1690000000 {
+		t.Errorf("decoded %v %v", o.ExpirationTime, o.ListingTime)
+	}
+
+	var n opensea.NFT
+	if err := json.Unmarshal([]byte(`{"updated_at":"2021-08-01T12:00:00.123456","rarity":{"calculated_at":"2021-08-01 12:00:00"}}`), &n); err != nil {
+		t.Fatal(err)
+	}
+	if n.UpdatedAt.Unix() != 1627819200 || n.Rarity.CalculatedAt.Unix() != 1627819200 {
+		t.Errorf("decoded %v %v", n.UpdatedAt, n.Rarity.CalculatedAt)
+	}
+}
@@ -0,0 +1,101 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestParseTokenID(t *testing.T) {
+	maxID := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	tests := map[string]string{
+		"0":    "0",
+		"007":  "7",
+		"0x2a": "42",
+		"0XFF": "255",
+		maxID:  maxID,
+		"0x" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff": maxID,
+	}
+	for in, want := range tests {
+		id, err := opensea.ParseTokenID(in)
+		if err != nil {
+			t.Fatalf("ParseTokenID(%q): %v", in, err)
+		}
+		if id.String() != want {
+			t.Errorf("ParseTokenID(%q) = %s, want %s", in, id, want)
+		}
+	}
+
+	tooBig := new(big.Int).Add(opensea.MustParseTokenID(maxID).Big(), big.NewInt(1)).String()
+	for _, in := range []string{"", "-1", "+1", "1.5", "0x", "abc", tooBig} {
+		if _, err := opensea.ParseTokenID(in); !errors.Is(err, opensea.ErrInvalidTokenID) {
+			t.Errorf("ParseTokenID(%q) error = %v, want ErrInvalidTokenID", in, err)
+		}
+	}
+}
+
+func TestTokenIDCompare(t *testing.T) {
+	a := opensea.MustParseTokenID("0x10")
+	b := opensea.TokenIDFromUint64(16)
+	if a != b {
+		t.Error("equal token IDs are not ==")
+	}
+	if a.Hex() != "0x10" {
+		t.Errorf("Hex() = %s", a.Hex())
+	}
+	if c := opensea.TokenIDFromUint64(9).Cmp(a); c != -1 {
+		t.Errorf("Cmp(9, 16) = %d", c)
+	}
+	if c := (opensea.TokenID{}).Cmp(opensea.TokenIDFromUint64(0)); c != -1 {
+		t.Errorf("Cmp(unset, 0) = %d", c)
+	}
+	if (opensea.TokenID{}).IsSet() || !opensea.TokenIDFromUint64(0).IsSet() {
+		t.Error("token 0 must be set and the zero value unset")
+	}
+}
+
+func TestTokenIDJSON(t *testing.T) {
+	var v struct {
+		A opensea.TokenID `json:"a"`
+		B opensea.TokenID `json:"b"`
+		C opensea.TokenID `json:"c"`
+	}
+	err := json.Unmarshal([]byte(`{"a":"1234567890123456789012345678901234567890","b":7,"c":null}`), &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.A.String() != "1234567890123456789012345678901234567890" || v.B.String() != "7" || v.C.IsSet() {
+		t.Errorf("decoded %v %v %v", v.A, v.B, v.C)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"a":"1234567890123456789012345678901234567890","b":"7","c":null}` {
+		t.Errorf("Marshal = %s", out)
+	}
+
+	typ, data, err := v.A.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got opensea.TokenID
+	if err := got.UnmarshalBSONValue(typ, data); err != nil || got != v.A {
+		t.Errorf("BSON round trip = %v, %v", got, err)
+	}
+}
+
+func TestRetrievingEventsParamsTokenZero(t *testing.T) {
+	p := opensea.NewRetrievingEventsParams()
+	if q, _ := url.ParseQuery(p.Encode()); q.Has("token_id") {
+		t.Error("unset token ID was sent")
+	}
+	p.TokenID = opensea.TokenIDFromUint64(0)
+	if q, _ := url.ParseQuery(p.Encode()); q.Get("token_id") != "0" {
+		t.Errorf("token_id = %q, want 0", q.Get("token_id"))
+	}
+}
@@ -0,0 +1,101 @@
+package opensea_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func TestTraitsDecode(t *testing.T) {
+	var a opensea.Asset
+	err := json.Unmarshal([]byte(`{"traits": [
+		{"trait_type": "Hat", "value": "Cap", "display_type": null, "max_value": null, "trait_count": 312, "order": null},
+		{"trait_type": "Level", "value": 5, "display_type": "number", "max_value": "10", "trait_count": 0, "order": 2},
+		{"trait_type": "Stamina", "value": 1.5, "display_type": "boost_number"},
+		{"trait_type": "Born", "value": 1627819200, "display_type": "date"},
+		{"trait_type": "Legendary", "value": true}
+	]}`), &a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hat, ok := a.Traits.Get("hat")
+	if !ok || hat.Value.String() != "Cap" || hat.Value.IsNumber() || hat.TraitCount != 312 || hat.MaxValue != nil {
+		t.Errorf("Get(hat) = %+v, %v", hat, ok)
+	}
+	level, _ := a.Traits.Get("Level")
+	if level.DisplayType != opensea.DisplayTypeNumber || level.MaxValue == nil || level.MaxValue.String() != "10" || *level.Order != 2 {
+		t.Errorf("Get(Level) = %+v", level)
+	}
+	if n, ok := a.Traits.Numeric("Stamina"); !ok || n.String() != "1.5" {
+		t.Errorf("Numeric(Stamina) = %s, %v", n, ok)
+	}
+	if _, ok := a.Traits.Numeric("Hat"); ok {
+		t.Error("Numeric(Hat) reported a number")
+	}
+	born, _ := a.Traits.Get("Born")
+	if ts, ok := born.Time(); !ok || ts.Unix() != 1627819200 {
+		t.Errorf("Born.Time() = %v, %v", ts, ok)
+	}
+	if !a.Traits.Has("legendary") || a.Traits.Has("Missing") {
+		t.Error("Has returned the wrong result")
+	}
+
+	out, err := json.Marshal(a.Traits[1:3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"trait_type":"Level","value":5,"display_type":"number","max_value":"10","order":2,"trait_count":0},` +
+		`{"trait_type":"Stamina","value":1.5,"display_type":"boost_number","max_value":null,"order":null,"trait_count":0}]`
+	if string(out) != want {
+		t.Errorf("Marshal = %s", out)
+	}
+}
+
+func TestTraitsDecodeShapes(t *testing.T) {
+	tests := map[string]int{
+		`null`:                       0,
+		`[]`:                         0,
+		`{"Hat": "Cap", "Level": 3}`: 2,
+	}
+	for in, n := range tests {
+		var ts opensea.Traits
+		if err := json.Unmarshal([]byte(in), &ts); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", in, err)
+		}
+		if len(ts) != n {
+			t.Errorf("Unmarshal(%s) = %d traits, want %d", in, len(ts), n)
+		}
+	}
+
+	var ts opensea.Traits
+	if err := json.Unmarshal([]byte(`{"Level": 3, "Hat": "Cap"}`), &ts); err != nil {
+		t.Fatal(err)
+	}
+	if ts[0].TraitType != "Hat" || ts[1].TraitType != "Level" {
+		t.Errorf("object traits not sorted: %+v", ts)
+	}
+	if n, ok := ts.Numeric("Level"); !ok || n.String() != "3" {
+		t.Errorf("Numeric(Level) = %s, %v", n, ok)
+	}
+}
+
+func TestTraitValueBSON(t *testing.T) {
+	for _, v := range []opensea.TraitValue{
+		opensea.StringTraitValue("Cap"),
+		opensea.NumberTraitValue(opensea.MustParseDecimal("1.1")),
+	} {
+		typ, data, err := v.MarshalBSONValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got opensea.TraitValue
+		if err := got.UnmarshalBSONValue(typ, data); err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != v.String() || got.IsNumber() != v.IsNumber() {
+			t.Errorf("round trip = %v, want %v", got, v)
+		}
+	}
+}
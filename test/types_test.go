@@ -11,7 +11,9 @@ func TestParseAddress(t *testing.T) {
 		want    Address
 		wantErr bool
 	}{
-		{"Valid address", "0x1234567890abcdef", Address("0x1234567890abcdef"), false},
+		{"Valid address", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Address("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"), false},
+		{"Bad checksum", "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", NullAddress, true},
+		{"Too short", "0x1234567890abcdef", NullAddress, true},
 		{"Empty address", "", NullAddress, true},
 	}
 
@@ -52,7 +54,7 @@ func TestNumberString(t *testing.T) {
 
 func TestBytesString(t *testing.T) {
 	bytes := Bytes([]byte("hello"))
-	if got := bytes.String(); got != "hello" {
-		t.Errorf("Bytes.String() = %v, want %v", got, "hello")
+	if got := bytes.String(); got != "0x68656c6c6f" {
+		t.Errorf("Bytes.String() = %v, want %v", got, "0x68656c6c6f")
 	}
 }
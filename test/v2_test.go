@@ -0,0 +1,123 @@
+package opensea_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	opensea "github.com/naevern/gopenseapi"
+)
+
+func newV2Server(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	routes := map[string]string{
+		"/api/v2/chain/ethereum/contract/0xabc/nfts/1":        `{"nft":{"identifier":"1","collection":"punks","contract":"0xabc","token_standard":"erc721","traits":[{"trait_type":"Hat","value":"Cap"}],"owners":[{"address":"0xowner","quantity":1}],"rarity":{"rank":7}}}`,
+		"/api/v2/chain/ethereum/contract/0xabc":               `{"address":"0xabc","chain":"ethereum","collection":"punks","contract_standard":"erc721","name":"Punks","total_supply":10000}`,
+		"/api/v2/collection/punks/nfts":                       `{"nfts":[{"identifier":"1"},{"identifier":"2"}],"next":"abc"}`,
+		"/api/v2/chain/ethereum/account/0xowner/nfts":         `{"nfts":[{"identifier":"1"}]}`,
+		"/api/v2/chain/ethereum/contract/0xabc/nfts":          `{"nfts":[{"identifier":"3"}]}`,
+		"/api/v2/collections/punks":                           `{"collection":"punks","name":"Punks","contracts":[{"address":"0xabc","chain":"ethereum"}],"fees":[{"fee":2.5,"recipient":"0xfee","required":true}]}`,
+		"/api/v2/collections/punks/stats":                     `{"total":{"volume":12.5,"sales":3,"num_owners":2,"floor_price":1.1,"floor_price_symbol":"ETH"},"intervals":[{"interval":"one_day","volume":1}]}`,
+		"/api/v2/events/collection/punks":                     `{"asset_events":[{"event_type":"sale","chain":"ethereum","payment":{"quantity":"1000000000000000000","decimals":18,"symbol":"ETH"},"nft":{"identifier":"1"}}],"next":"n"}`,
+		"/api/v2/events/accounts/0xowner":                     `{"asset_events":[{"event_type":"transfer"}]}`,
+		"/api/v2/events/chain/ethereum/contract/0xabc/nfts/1": `{"asset_events":[{"event_type":"mint"}]}`,
+		"/api/v2/listings/collection/punks/all":               `{"listings":[{"order_hash":"0x1","chain":"ethereum","price":{"current":{"currency":"ETH","decimals":18,"value":"500"}},"protocol_data":{"parameters":{"offerer":"0xowner","offer":[{"itemType":2,"token":"0xabc","identifierOrCriteria":"1","startAmount":"1","endAmount":"1"}]}}}]}`,
+		"/api/v2/offers/collection/punks/all":                 `{"offers":[{"order_hash":"0x2","price":{"currency":"WETH","decimals":18,"value":"400"},"criteria":{"collection":{"slug":"punks"}}}]}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+}
+
+func TestClient_V2Endpoints(t *testing.T) {
+	srv := newV2Server(t)
+	defer srv.Close()
+
+	client := opensea.NewClient(srv.URL, "test-api-key")
+	ctx := context.Background()
+
+	nft, err := client.GetNFTV2(ctx, "ethereum", "0xabc", opensea.TokenIDFromUint64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nft.Identifier.String() != "1" || len(nft.Owners) != 1 || nft.Rarity.Rank != 7 || nft.Traits[0].TraitType != "Hat" {
+		t.Errorf("unexpected NFT %+v", nft)
+	}
+
+	contract, err := client.GetContractV2(ctx, "ethereum", "0xabc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contract.TotalSupply != 10000 || contract.ContractStandard != "erc721" {
+		t.Errorf("unexpected contract %+v", contract)
+	}
+
+	nfts, err := client.ListNFTsByCollection(ctx, "punks", opensea.ListParams{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nfts.NFTs) != 2 || nfts.Next != "abc" {
+		t.Errorf("unexpected NFTs page %+v", nfts)
+	}
+	if _, err := client.ListNFTsByAccount(ctx, "ethereum", "0xowner", opensea.ListParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListNFTsByContract(ctx, "ethereum", "0xabc", opensea.ListParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	collection, err := client.GetCollection(ctx, "punks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if collection.Name != "Punks" || collection.Fees[0].Fee != 2.5 || collection.Contracts[0].Chain != "ethereum" {
+		t.Errorf("unexpected collection %+v", collection)
+	}
+
+	stats, err := client.GetCollectionStats(ctx, "punks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Total.FloorPrice != 1.1 || stats.Intervals[0].Interval != "one_day" {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	events, err := client.ListEventsByCollection(ctx, "punks", opensea.EventsParams{EventTypes: []opensea.EventType{opensea.EventTypeSale}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events.AssetEvents[0].EventType != opensea.EventTypeSale || events.AssetEvents[0].Payment.Decimals != 18 {
+		t.Errorf("unexpected events %+v", events)
+	}
+	if _, err := client.ListEventsByAccount(ctx, "0xowner", opensea.EventsParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListEventsByNFT(ctx, "ethereum", "0xabc", opensea.TokenIDFromUint64(1), opensea.EventsParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	listings, err := client.GetAllListings(ctx, "punks", opensea.ListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listings.Listings[0].Price.Current.Value != "500" || listings.Listings[0].ProtocolData.Parameters.Offer[0].Token != "0xabc" {
+		t.Errorf("unexpected listings %+v", listings)
+	}
+
+	offers, err := client.GetAllOffers(ctx, "punks", opensea.ListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offers.Offers[0].Criteria.Collection.Slug != "punks" {
+		t.Errorf("unexpected offers %+v", offers)
+	}
+}
@@ -0,0 +1,171 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTimestamp is returned when decoding a malformed timestamp.
+var ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+// Timestamp is a point in time decoded from any form OpenSea uses: ISO-8601
+// strings with or without a zone, Unix seconds or Unix milliseconds, given
+// as JSON strings or numbers. Times without a zone are UTC. The zero value
+// is the zero time and encodes as null; a Unix time of 0, which OpenSea
+// uses for unset dates, decodes to it.
+type Timestamp struct {
+	t time.Time
+}
+
+// NewTimestamp returns t as a Timestamp.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t: t}
+}
+
+// Time returns the time.Time of t.
+func (t Timestamp) Time() time.Time {
+	return t.t
+}
+
+// IsZero reports whether t is the zero time.
+func (t Timestamp) IsZero() bool {
+	return t.t.IsZero()
+}
+
+// Unix returns t as Unix seconds.
+func (t Timestamp) Unix() int64 {
+	return t.t.Unix()
+}
+
+// String returns t in RFC 3339 format in UTC, or "" for the zero time.
+func (t Timestamp) String() string {
+	if t.t.IsZero() {
+		return ""
+	}
+	return t.t.UTC().Format(time.RFC3339Nano)
+}
+
+// isoLayouts are tried in order by ParseTimestamp. time.Parse accepts a
+// fractional second after the seconds field even when the layout has none.
+var isoLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"20060102T150405Z07:00",
+	"20060102T150405",
+	"20060102",
+}
+
+// unixMillisThreshold separates Unix seconds from Unix milliseconds. As
+// seconds it is in the year 33658; as milliseconds it is September 2001.
+const unixMillisThreshold = 1e12
+
+// ParseTimestamp parses an ISO-8601 time or a decimal count of Unix seconds
+// or milliseconds. Counts of 10^12 or more are taken as milliseconds. ISO
+// layouts are tried first, so an all-digit string that is a valid compact
+// date such as "20210801" is a date, not a count of seconds.
+func ParseTimestamp(s string) (Timestamp, error) {
+	return parseTimestamp(s, false)
+}
+
+// parseTimestamp is ParseTimestamp for s that came from a JSON number when
+// number is set. Numbers are always Unix times.
+func parseTimestamp(s string, number bool) (Timestamp, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	if !number {
+		for _, layout := range isoLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return Timestamp{t: t}, nil
+			}
+		}
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return timestampFromUnix(n, s)
+	}
+	return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+}
+
+func timestampFromUnix(n float64, s string) (Timestamp, error) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+	}
+	if n == 0 {
+		return Timestamp{}, nil
+	}
+	if math.Abs(n) >= unixMillisThreshold {
+		return Timestamp{t: time.UnixMilli(int64(n)).UTC()}, nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return Timestamp{t: time.Unix(i, 0).UTC()}, nil
+	}
+	sec, frac := math.Modf(n)
+	return Timestamp{t: time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC()}, nil
+}
+
+// MarshalJSON encodes t as an RFC 3339 string, or null for the zero time.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a JSON string or number. Strings are parsed by
+// ParseTimestamp; numbers are always Unix times. null decodes to the zero
+// time.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	s, err := unquoteNumber(b)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal timestamp: %w", err)
+	}
+	b = bytes.TrimSpace(b)
+	v, err := parseTimestamp(s, len(b) > 0 && b[0] != '"')
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// MarshalBSONValue encodes t as an RFC 3339 BSON string, or BSON null for
+// the zero time. A string keeps the sub-millisecond precision a BSON
+// datetime would lose.
+func (t Timestamp) MarshalBSONValue() (byte, []byte, error) {
+	if t.t.IsZero() {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONString(t.String())
+}
+
+// UnmarshalBSONValue decodes a BSON string, datetime or null.
+func (t *Timestamp) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == bsonDateTime {
+		if len(data) != 8 {
+			return fmt.Errorf("failed to unmarshal timestamp: malformed BSON datetime")
+		}
+		*t = Timestamp{t: time.UnixMilli(int64(binary.LittleEndian.Uint64(data))).UTC()}
+		return nil
+	}
+	s, err := unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal timestamp: %w", err)
+	}
+	v, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
@@ -0,0 +1,160 @@
+package opensea
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidTokenID is returned when parsing a malformed token ID.
+var ErrInvalidTokenID = errors.New("invalid token ID")
+
+// maxTokenID is the largest token ID, 2^256-1.
+var maxTokenID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// TokenID is an ERC-721 or ERC-1155 token ID, an unsigned 256-bit integer.
+// It holds its value in canonical decimal form so that TokenIDs compare
+// with == and can be map keys; Big returns the value as a big.Int. The zero
+// value is unset, which is distinct from token 0.
+type TokenID struct {
+	dec string
+}
+
+// ParseTokenID parses a token ID in decimal or, with a "0x" prefix, hex.
+func ParseTokenID(s string) (TokenID, error) {
+	in := s
+	s = strings.TrimSpace(s)
+	base := 10
+	if h, ok := strings.CutPrefix(strings.ToLower(s), "0x"); ok {
+		s, base = h, 16
+	}
+	if s == "" || strings.ContainsAny(s, "+-") {
+		return TokenID{}, fmt.Errorf("%w: %q", ErrInvalidTokenID, in)
+	}
+	v, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return TokenID{}, fmt.Errorf("%w: %q", ErrInvalidTokenID, in)
+	}
+	return TokenIDFromBig(v)
+}
+
+// MustParseTokenID is ParseTokenID for constants known to be valid. It
+// panics on error.
+func MustParseTokenID(s string) TokenID {
+	id, err := ParseTokenID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenIDFromBig returns v as a TokenID. v must be in [0, 2^256).
+func TokenIDFromBig(v *big.Int) (TokenID, error) {
+	if v == nil || v.Sign() < 0 || v.Cmp(maxTokenID) > 0 {
+		return TokenID{}, fmt.Errorf("%w: %v is out of range", ErrInvalidTokenID, v)
+	}
+	return TokenID{dec: v.String()}, nil
+}
+
+// TokenIDFromUint64 returns n as a TokenID.
+func TokenIDFromUint64(n uint64) TokenID {
+	return TokenID{dec: new(big.Int).SetUint64(n).String()}
+}
+
+// IsSet reports whether id holds a token ID, including 0.
+func (id TokenID) IsSet() bool {
+	return id.dec != ""
+}
+
+// Big returns id as a big.Int, or nil if id is unset.
+func (id TokenID) Big() *big.Int {
+	if !id.IsSet() {
+		return nil
+	}
+	v, _ := new(big.Int).SetString(id.dec, 10)
+	return v
+}
+
+// String returns id in decimal, or "" if id is unset.
+func (id TokenID) String() string {
+	return id.dec
+}
+
+// Hex returns id as a "0x"-prefixed hex string, or "" if id is unset.
+func (id TokenID) Hex() string {
+	if !id.IsSet() {
+		return ""
+	}
+	return "0x" + id.Big().Text(16)
+}
+
+// Cmp returns -1, 0 or 1 as id is less than, equal to or greater than o.
+// Unset IDs sort before all others.
+func (id TokenID) Cmp(o TokenID) int {
+	switch {
+	case !id.IsSet() && !o.IsSet():
+		return 0
+	case !id.IsSet():
+		return -1
+	case !o.IsSet():
+		return 1
+	}
+	return id.Big().Cmp(o.Big())
+}
+
+// MarshalJSON encodes id as a decimal JSON string, or null if id is unset.
+func (id TokenID) MarshalJSON() ([]byte, error) {
+	if !id.IsSet() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + id.dec + `"`), nil
+}
+
+// UnmarshalJSON decodes a JSON string or number. null and "" decode to an
+// unset TokenID.
+func (id *TokenID) UnmarshalJSON(b []byte) error {
+	s, err := unquoteNumber(b)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal token ID: %w", err)
+	}
+	return id.set(s)
+}
+
+// MarshalBSONValue encodes id as a decimal BSON string, or BSON null if id
+// is unset.
+func (id TokenID) MarshalBSONValue() (byte, []byte, error) {
+	if !id.IsSet() {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONString(id.dec)
+}
+
+// UnmarshalBSONValue decodes a BSON string, integer or null.
+func (id *TokenID) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == bsonInt32 || typ == bsonInt64 {
+		s, _, err := unmarshalBSONNumber(typ, data)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal token ID: %w", err)
+		}
+		return id.set(s)
+	}
+	s, err := unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal token ID: %w", err)
+	}
+	return id.set(s)
+}
+
+func (id *TokenID) set(s string) error {
+	if s == "" {
+		*id = TokenID{}
+		return nil
+	}
+	v, err := ParseTokenID(s)
+	if err != nil {
+		return err
+	}
+	*id = v
+	return nil
+}
@@ -0,0 +1,220 @@
+package opensea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DisplayType says how a marketplace renders a trait.
+type DisplayType string
+
+const (
+	DisplayTypeNone            DisplayType = ""
+	DisplayTypeNumber          DisplayType = "number"
+	DisplayTypeBoostPercentage DisplayType = "boost_percentage"
+	DisplayTypeBoostNumber     DisplayType = "boost_number"
+	DisplayTypeDate            DisplayType = "date"
+)
+
+// TraitValue is the value of a trait, which is either a string or a number.
+// The zero value is the empty string.
+type TraitValue struct {
+	str   string
+	num   Decimal
+	isNum bool
+}
+
+// StringTraitValue returns s as a TraitValue.
+func StringTraitValue(s string) TraitValue {
+	return TraitValue{str: s}
+}
+
+// NumberTraitValue returns d as a TraitValue.
+func NumberTraitValue(d Decimal) TraitValue {
+	return TraitValue{num: d, isNum: true}
+}
+
+// IsNumber reports whether v is a number.
+func (v TraitValue) IsNumber() bool {
+	return v.isNum
+}
+
+// Number returns v as a number. A string value is parsed, so "7" is 7.
+func (v TraitValue) Number() (Decimal, bool) {
+	if v.isNum {
+		return v.num, true
+	}
+	d, err := ParseDecimal(v.str)
+	if err != nil {
+		return Decimal{}, false
+	}
+	return d, true
+}
+
+// String returns v as it would be displayed.
+func (v TraitValue) String() string {
+	if v.isNum {
+		return v.num.String()
+	}
+	return v.str
+}
+
+// MarshalJSON encodes v as a JSON number or string.
+func (v TraitValue) MarshalJSON() ([]byte, error) {
+	if v.isNum {
+		return []byte(v.num.String()), nil
+	}
+	return json.Marshal(v.str)
+}
+
+// UnmarshalJSON decodes a JSON string, number or boolean. Booleans become
+// the strings "true" and "false"; null becomes the empty string.
+func (v *TraitValue) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case string(b) == "null":
+		*v = TraitValue{}
+	case string(b) == "true" || string(b) == "false":
+		*v = StringTraitValue(string(b))
+	case len(b) > 0 && b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return fmt.Errorf("failed to unmarshal trait value: %w", err)
+		}
+		*v = StringTraitValue(s)
+	default:
+		d, err := ParseDecimal(string(b))
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal trait value: %w", err)
+		}
+		*v = NumberTraitValue(d)
+	}
+	return nil
+}
+
+// MarshalBSONValue encodes v as a BSON double or string.
+func (v TraitValue) MarshalBSONValue() (byte, []byte, error) {
+	if v.isNum {
+		return marshalBSONDouble(v.num.Float64())
+	}
+	return marshalBSONString(v.str)
+}
+
+// UnmarshalBSONValue decodes a BSON number, string, boolean or null.
+func (v *TraitValue) UnmarshalBSONValue(typ byte, data []byte) error {
+	s, ok, err := unmarshalBSONNumber(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal trait value: %w", err)
+	}
+	if ok {
+		d, err := ParseDecimal(s)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal trait value: %w", err)
+		}
+		*v = NumberTraitValue(d)
+		return nil
+	}
+	if typ == bsonBool {
+		if len(data) != 1 {
+			return fmt.Errorf("failed to unmarshal trait value: malformed BSON boolean")
+		}
+		*v = StringTraitValue(strconv.FormatBool(data[0] != 0))
+		return nil
+	}
+	s, err = unmarshalBSONString(typ, data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal trait value: %w", err)
+	}
+	*v = StringTraitValue(s)
+	return nil
+}
+
+// Trait is a single attribute of an asset, with the number of assets in the
+// collection that share it when OpenSea reports one.
+type Trait struct {
+	TraitType   string      `json:"trait_type" bson:"trait_type"`
+	Value       TraitValue  `json:"value" bson:"value"`
+	DisplayType DisplayType `json:"display_type" bson:"display_type"`
+	MaxValue    *Decimal    `json:"max_value" bson:"max_value"`
+	Order       *int64      `json:"order" bson:"order"`
+	TraitCount  int64       `json:"trait_count" bson:"trait_count"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
+}
+
+// Time returns the value of a date trait, which is in Unix seconds.
+func (t Trait) Time() (Timestamp, bool) {
+	if t.DisplayType != DisplayTypeDate {
+		return Timestamp{}, false
+	}
+	ts, err := ParseTimestamp(t.Value.String())
+	if err != nil {
+		return Timestamp{}, false
+	}
+	return ts, true
+}
+
+// Traits is the list of traits of an asset.
+type Traits []Trait
+
+// Get returns the trait with the given type, compared case-insensitively.
+func (ts Traits) Get(traitType string) (Trait, bool) {
+	for _, t := range ts {
+		if strings.EqualFold(t.TraitType, traitType) {
+			return t, true
+		}
+	}
+	return Trait{}, false
+}
+
+// Has reports whether ts contains a trait with the given type.
+func (ts Traits) Has(traitType string) bool {
+	_, ok := ts.Get(traitType)
+	return ok
+}
+
+// Numeric returns the value of the trait with the given type as a number.
+// It reports false if there is no such trait or its value is not numeric.
+func (ts Traits) Numeric(traitType string) (Decimal, bool) {
+	t, ok := ts.Get(traitType)
+	if !ok {
+		return Decimal{}, false
+	}
+	return t.Value.Number()
+}
+
+// UnmarshalJSON decodes a list of traits, null, or an object mapping trait
+// types to values as found in some token metadata.
+func (ts *Traits) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case string(b) == "null":
+		*ts = nil
+		return nil
+	case len(b) > 0 && b[0] == '{':
+		var m map[string]TraitValue
+		if err := json.Unmarshal(b, &m); err != nil {
+			return fmt.Errorf("failed to unmarshal traits: %w", err)
+		}
+		out := make(Traits, 0, len(m))
+		for k, v := range m {
+			out = append(out, Trait{TraitType: k, Value: v})
+		}
+		// Map order is random; sort by trait type for stable output.
+		slices.SortFunc(out, func(a, b Trait) int {
+			return strings.Compare(a.TraitType, b.TraitType)
+		})
+		*ts = out
+		return nil
+	}
+	var list []Trait
+	if err := json.Unmarshal(b, &list); err != nil {
+		return fmt.Errorf("failed to unmarshal traits: %w", err)
+	}
+	*ts = list
+	return nil
+}
@@ -1,29 +1,19 @@
 package opensea
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
-type Address string
-
-func ParseAddress(addr string) (Address, error) {
-	if addr == "" {
-		return "", fmt.Errorf("empty address")
-	}
-	return Address(addr), nil
-}
-
-const NullAddress Address = ""
-
 type Collection struct {
 	// todo: Support commented fields in Collection struct for /collections GET request
 	BannerImageUrl              string      `json:"banner_image_url" bson:"banner_image_url"`
 	ChatUrl                     string      `json:"chat_url" bson:"chat_url"`
-	CreatedDate                 string      `json:"created_date" bson:"created_date"`
+	CreatedDate                 Timestamp   `json:"created_date" bson:"created_date"`
 	DefaultToFiat               bool        `json:"default_to_fiat" bson:"default_to_fiat"`
 	Description                 string      `json:"description" bson:"description"`
-	DevBuyerFeeBasisPoints      string      `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     string      `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
+	DevBuyerFeeBasisPoints      BasisPoints `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
+	DevSellerFeeBasisPoints     BasisPoints `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
 	DiscordUrl                  string      `json:"discord_url" bson:"discord_url"`
 	DisplayData                 interface{} `json:"display_data" bson:"display_data"`
 	ExternalUrl                 string      `json:"external_url" bson:"external_url"`
@@ -37,8 +27,8 @@ type Collection struct {
 	MediumUsername              string      `json:"medium_username" bson:"medium_username"`
 	Name                        string      `json:"name" bson:"name"`
 	OnlyProxiedTransfers        bool        `json:"only_proxied_transfers" bson:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  string      `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints string      `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
+	OpenseaBuyerFeeBasisPoints  BasisPoints `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
+	OpenseaSellerFeeBasisPoints BasisPoints `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
 	PayoutAddress               string      `json:"payout_address" bson:"payout_address"`
 	RequireEmail                bool        `json:"require_email" bson:"require_email"`
 	ShortDescription            string      `json:"short_description" bson:"short_description"`
@@ -47,10 +37,14 @@ type Collection struct {
 	TwitterUsername             string      `json:"twitter_username" bson:"twitter_username"`
 	InstagramUsername           string      `json:"instagram_username" bson:"instagram_username"`
 	WikiUrl                     string      `json:"wiki_url" bson:"wiki_url"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 type User struct {
 	Username string `json:"username" bson:"username"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 type Account struct {
@@ -59,12 +53,14 @@ type Account struct {
 	Address       Address `json:"address" bson:"address"`
 	Config        string  `json:"config" bson:"config"`
 	DiscordID     string  `json:"discord_id" bson:"discord_id"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 type NFTContract struct {
 	Address                     Address     `json:"address" bson:"address"`
 	AssetContractType           string      `json:"asset_contract_type" bson:"asset_contract_type"`
-	CreatedDate                 string      `json:"created_date" bson:"created_date"`
+	CreatedDate                 Timestamp   `json:"created_date" bson:"created_date"`
 	Name                        string      `json:"name" bson:"name"`
 	NftVersion                  string      `json:"nft_version" bson:"nft_version"`
 	OpenseaVersion              interface{} `json:"opensea_version" bson:"opensea_version"`
@@ -76,20 +72,23 @@ type NFTContract struct {
 	ExternalLink                string      `json:"external_link" bson:"external_link"`
 	ImageURL                    string      `json:"image_url" bson:"image_url"`
 	DefaultToFiat               bool        `json:"default_to_fiat" bson:"default_to_fiat"`
-	DevBuyerFeeBasisPoints      int64       `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
-	DevSellerFeeBasisPoints     int64       `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
+	DevBuyerFeeBasisPoints      BasisPoints `json:"dev_buyer_fee_basis_points" bson:"dev_buyer_fee_basis_points"`
+	DevSellerFeeBasisPoints     BasisPoints `json:"dev_seller_fee_basis_points" bson:"dev_seller_fee_basis_points"`
 	OnlyProxiedTransfers        bool        `json:"only_proxied_transfers" bson:"only_proxied_transfers"`
-	OpenseaBuyerFeeBasisPoints  int64       `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
-	OpenseaSellerFeeBasisPoints int64       `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
-	BuyerFeeBasisPoints         int64       `json:"buyer_fee_basis_points" bson:"buyer_fee_basis_points"`
-	SellerFeeBasisPoints        int64       `json:"seller_fee_basis_points" bson:"seller_fee_basis_points"`
+	OpenseaBuyerFeeBasisPoints  BasisPoints `json:"opensea_buyer_fee_basis_points" bson:"opensea_buyer_fee_basis_points"`
+	OpenseaSellerFeeBasisPoints BasisPoints `json:"opensea_seller_fee_basis_points" bson:"opensea_seller_fee_basis_points"`
+	BuyerFeeBasisPoints         BasisPoints `json:"buyer_fee_basis_points" bson:"buyer_fee_basis_points"`
+	SellerFeeBasisPoints        BasisPoints `json:"seller_fee_basis_points" bson:"seller_fee_basis_points"`
 	PayoutAddress               Address     `json:"payout_address" bson:"payout_address"`
+	Chain                       Chain       `json:"chain,omitempty" bson:"chain,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
 type Asset struct {
 	// todo: Support commented fields in Asset struct
 	ID                   int64        `json:"id" bson:"id"`
-	TokenID              string       `json:"token_id" bson:"token_id"`
+	TokenID              TokenID      `json:"token_id" bson:"token_id"`
 	NumSales             int64        `json:"num_sales" bson:"num_sales"`
 	BackgroundColor      string       `json:"background_color" bson:"background_color"`
 	ImageURL             string       `json:"image_url" bson:"image_url"`
@@ -107,13 +106,26 @@ type Asset struct {
 	Decimals             int64        `json:"decimals" bson:"decimals"`
 	TokenMetadata        string       `json:"token_metadata" bson:"token_metadata"`
 	Owner                *Account     `json:"owner" bson:"owner"`
-	Traits               interface{}  `json:"traits" bson:"traits"`
+	Traits               Traits       `json:"traits" bson:"traits"`
+	Chain                Chain        `json:"chain,omitempty" bson:"chain,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-" bson:"extra_fields,omitempty"`
 }
 
-func (a Address) String() string {
-	return string(a)
+// setChain records chain on the asset and its contract when they have none.
+func (a *Asset) setChain(chain Chain) {
+	if a.Chain == "" {
+		a.Chain = chain
+	}
+	if a.AssetContract != nil && a.AssetContract.Chain == "" {
+		a.AssetContract.Chain = chain
+	}
 }
 
+// TimeNano is an integer timestamp.
+//
+// Deprecated: TimeNano cannot decode OpenSea's ISO-8601 dates. Use
+// Timestamp instead.
 type TimeNano int64
 
 func (t TimeNano) String() string {
@@ -126,8 +138,10 @@ func (n Number) String() string {
 	return string(n)
 }
 
-type Bytes []byte
-
-func (b Bytes) String() string {
-	return string(b)
+// Decimal parses n exactly. An empty Number is 0.
+func (n Number) Decimal() (Decimal, error) {
+	if n == "" {
+		return Decimal{}, nil
+	}
+	return ParseDecimal(string(n))
 }